@@ -431,6 +431,7 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 		appCodec,
 		*legacyAmino,
 		ak.keys[compute.StoreKey],
+		ak.GetSubspace(compute.ModuleName),
 		*ak.AccountKeeper,
 		ak.BankKeeper,
 		*ak.GovKeeper,
@@ -73,7 +73,7 @@ func Modules(
 		staking.NewAppModule(appCodec, *app.AppKeepers.StakingKeeper, app.AppKeepers.AccountKeeper, *app.AppKeepers.BankKeeper),
 		upgrade.NewAppModule(*app.AppKeepers.UpgradeKeeper),
 		evidence.NewAppModule(*app.AppKeepers.EvidenceKeeper),
-		compute.NewAppModule(*app.AppKeepers.ComputeKeeper),
+		compute.NewAppModule(*app.AppKeepers.ComputeKeeper, *app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper),
 		params.NewAppModule(*app.AppKeepers.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, *app.AppKeepers.AuthzKeeper, app.AppKeepers.AccountKeeper, *app.AppKeepers.BankKeeper, app.GetInterfaceRegistry()),
 		reg.NewAppModule(*app.AppKeepers.RegKeeper),
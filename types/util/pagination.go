@@ -0,0 +1,30 @@
+package util
+
+import (
+	"encoding/base64"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	flag "github.com/spf13/pflag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReadPageRequest reads the pagination flags like client.ReadPageRequest, but expects the page key base64
+// encoded, the way next_key is printed in query responses
+func ReadPageRequest(flagSet *flag.FlagSet) (*query.PageRequest, error) {
+	pageReq, err := client.ReadPageRequest(flagSet)
+	if err != nil {
+		return nil, err
+	}
+	if len(pageReq.Key) == 0 {
+		return pageReq, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(string(pageReq.Key))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page key: %s", err)
+	}
+	pageReq.Key = key
+	return pageReq, nil
+}
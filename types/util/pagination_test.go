@@ -0,0 +1,47 @@
+package util
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReadPageRequest(t *testing.T) {
+	specs := map[string]struct {
+		pageKey string
+		expKey  []byte
+		expCode codes.Code
+	}{
+		"no page key": {
+			expKey: []byte{},
+		},
+		"base64 page key": {
+			pageKey: base64.StdEncoding.EncodeToString([]byte{0x01, 0xff}),
+			expKey:  []byte{0x01, 0xff},
+		},
+		"malformed page key": {
+			pageKey: "not base64!",
+			expCode: codes.InvalidArgument,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags.AddPaginationFlagsToCmd(cmd, "test")
+			require.NoError(t, cmd.Flags().Set(flags.FlagPageKey, spec.pageKey))
+
+			pageReq, err := ReadPageRequest(cmd.Flags())
+			if spec.expCode != codes.OK {
+				require.Equal(t, spec.expCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expKey, pageReq.Key)
+		})
+	}
+}
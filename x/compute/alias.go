@@ -43,11 +43,15 @@ var (
 	GetContractAddressKey     = types.GetContractAddressKey
 	GetContractStorePrefixKey = types.GetContractStorePrefixKey
 	NewCodeInfo               = types.NewCodeInfo
+	AllowEverybody            = types.AllowEverybody
+	AllowNobody               = types.AllowNobody
 	NewAbsoluteTxPosition     = types.NewAbsoluteTxPosition
 	NewContractInfo           = types.NewContractInfo
 	NewEnv                    = types.NewEnv
 	NewWasmCoins              = types.NewWasmCoins
 	DefaultWasmConfig         = types.DefaultWasmConfig
+	DefaultParams             = types.DefaultParams
+	ParamKeyTable             = types.ParamKeyTable
 	IsEncryptedError          = types.IsEncryptedErrorCode
 	ErrContainsQueryError     = types.ErrContainsQueryError
 	GetConfig                 = types.GetConfig
@@ -90,6 +94,8 @@ var (
 	ErrNotFound          = types.ErrNotFound
 	ErrQueryFailed       = types.ErrQueryFailed
 	ErrInvalidMsg        = types.ErrInvalidMsg
+	ErrContractPaused    = types.ErrContractPaused
+	ErrOutOfStorage      = types.ErrOutOfStorage
 	KeyLastCodeID        = types.KeyLastCodeID
 	KeyLastInstanceID    = types.KeyLastInstanceID
 	CodeKeyPrefix        = types.CodeKeyPrefix
@@ -100,32 +106,42 @@ var (
 
 type (
 	// ProposalType            = types.ProposalType
-	GenesisState               = types.GenesisState
-	Code                       = types.Code
-	Contract                   = types.Contract
-	MsgStoreCode               = types.MsgStoreCode
-	MsgInstantiateContract     = types.MsgInstantiateContract
-	MsgExecuteContract         = types.MsgExecuteContract
-	MsgExecuteContractResponse = types.MsgExecuteContractResponse
-	MsgMigrateContract         = types.MsgMigrateContract
-	MsgUpdateAdmin             = types.MsgUpdateAdmin
-	MsgClearAdmin              = types.MsgClearAdmin
-	Model                      = types.Model
-	CodeInfo                   = types.CodeInfo
-	ContractInfo               = types.ContractInfo
-	CreatedAt                  = types.AbsoluteTxPosition
-	WasmConfig                 = types.WasmConfig
-	CodeInfoResponse           = types.CodeInfoResponse
-	MessageHandler             = keeper.SDKMessageHandler
-	BankEncoder                = keeper.BankEncoder
-	CustomEncoder              = keeper.CustomEncoder
-	StakingEncoder             = keeper.StakingEncoder
-	WasmEncoder                = keeper.WasmEncoder
-	GovEncoder                 = keeper.GovEncoder
-	MessageEncoders            = keeper.MessageEncoders
-	Keeper                     = keeper.Keeper
-	ContractInfoWithAddress    = types.ContractInfoWithAddress
-	QueryHandler               = keeper.QueryHandler
-	CustomQuerier              = keeper.CustomQuerier
-	QueryPlugins               = keeper.QueryPlugins
+	GenesisState                = types.GenesisState
+	Code                        = types.Code
+	Contract                    = types.Contract
+	MsgStoreCode                = types.MsgStoreCode
+	MsgInstantiateContract      = types.MsgInstantiateContract
+	MsgExecuteContract          = types.MsgExecuteContract
+	MsgExecuteContractResponse  = types.MsgExecuteContractResponse
+	MsgMigrateContract          = types.MsgMigrateContract
+	MsgUpdateAdmin              = types.MsgUpdateAdmin
+	MsgClearAdmin               = types.MsgClearAdmin
+	MsgPauseContract            = types.MsgPauseContract
+	MsgResumeContract           = types.MsgResumeContract
+	MsgTerminateContract        = types.MsgTerminateContract
+	MsgProposeContractMigration = types.MsgProposeContractMigration
+	MsgCancelContractMigration  = types.MsgCancelContractMigration
+	ContractExecutionAllowance  = types.ContractExecutionAllowance
+	Model                       = types.Model
+	CodeInfo                    = types.CodeInfo
+	AccessConfig                = types.AccessConfig
+	Params                      = types.Params
+	ContractInfo                = types.ContractInfo
+	CreatedAt                   = types.AbsoluteTxPosition
+	WasmConfig                  = types.WasmConfig
+	CodeInfoResponse            = types.CodeInfoResponse
+	MessageHandler              = keeper.SDKMessageHandler
+	BankEncoder                 = keeper.BankEncoder
+	CustomEncoder               = keeper.CustomEncoder
+	StakingEncoder              = keeper.StakingEncoder
+	WasmEncoder                 = keeper.WasmEncoder
+	GovEncoder                  = keeper.GovEncoder
+	MessageEncoders             = keeper.MessageEncoders
+	Keeper                      = keeper.Keeper
+	InstantiateOptions          = keeper.InstantiateOptions
+	CreateOptions               = keeper.CreateOptions
+	ContractInfoWithAddress     = types.ContractInfoWithAddress
+	QueryHandler                = keeper.QueryHandler
+	CustomQuerier               = keeper.CustomQuerier
+	QueryPlugins                = keeper.QueryPlugins
 )
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// GetCmdCodeHashOfFile computes the code hash a wasm file will be stored with, without a node
+func GetCmdCodeHashOfFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-hash-of-file [wasm_file]",
+		Short: "Compute the code hash a wasm file will be stored with",
+		Long: `Compute the code hash a wasm file will be stored with, the same way the chain does it.
+The file can be a wasm binary or a gzip compressed one. Nothing is sent to a node, so the code hash can be
+used to prepare encrypted init messages before the code is stored.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			codeHash, gzipped, err := codeHashOfFile(file)
+			if err != nil {
+				return err
+			}
+
+			if !gzipped {
+				fmt.Fprintf(cmd.ErrOrStderr(), "warning: %s isn't gzip compressed. The chain accepts code of at most %d bytes, "+
+					"so compress it before storing it with anything but `tx compute store`, which does this for you\n", args[0], types.MaxWasmSize)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(codeHash))
+			return nil
+		},
+		SilenceUsage: true,
+	}
+
+	return cmd
+}
+
+// codeHashOfFile returns the sha256 hash of the uncompressed wasm code in file, which is the code
+// hash the chain stores the code with, and whether file was gzip compressed. Like the chain it
+// uncompresses at most MaxWasmSize bytes.
+func codeHashOfFile(file []byte) ([]byte, bool, error) {
+	gzipped := len(file) >= 3 && wasmUtils.IsGzip(file)
+
+	wasm := file
+	if gzipped {
+		zr, err := gzip.NewReader(bytes.NewReader(file))
+		if err != nil {
+			return nil, false, err
+		}
+		zr.Multistream(false)
+
+		wasm, err = io.ReadAll(io.LimitReader(zr, types.MaxWasmSize))
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if len(wasm) < 4 || !wasmUtils.IsWasm(wasm) {
+		return nil, false, fmt.Errorf("invalid input file. Use wasm binary or gzip")
+	}
+
+	codeHash := sha256.Sum256(wasm)
+	return codeHash[:], gzipped, nil
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+)
+
+// sha256 of testdata/contract.wasm, an empty wasm module
+const contractCodeHash = "93a44bbb96c751218e4c00d479e4c14358122a389acca16205b1e4d0dc5f9476"
+
+func TestCodeHashOfFile(t *testing.T) {
+	notGzippedWasm, err := wasmUtils.GzipIt([]byte("not a wasm binary"))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		file       string
+		content    []byte
+		expGzipped bool
+		expErr     bool
+	}{
+		"wasm": {
+			file: "contract.wasm",
+		},
+		"gzip": {
+			file:       "contract.wasm.gz",
+			expGzipped: true,
+		},
+		"not wasm": {
+			file:   "master_io_key.txt",
+			expErr: true,
+		},
+		"gzip of not wasm": {
+			content: notGzippedWasm,
+			expErr:  true,
+		},
+		"empty": {
+			content: []byte{},
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			content := spec.content
+			if spec.file != "" {
+				var err error
+				content, err = os.ReadFile(filepath.Join("testdata", spec.file))
+				require.NoError(t, err)
+			}
+
+			codeHash, gzipped, err := codeHashOfFile(content)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expGzipped, gzipped)
+			require.Equal(t, contractCodeHash, hex.EncodeToString(codeHash))
+		})
+	}
+}
+
+func TestCodeHashOfFileCmd(t *testing.T) {
+	for _, spec := range []struct {
+		file       string
+		expWarning bool
+	}{
+		{file: "contract.wasm", expWarning: true},
+		{file: "contract.wasm.gz", expWarning: false},
+	} {
+		t.Run(spec.file, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			cmd := GetCmdCodeHashOfFile()
+			cmd.SetOut(&stdout)
+			cmd.SetErr(&stderr)
+			cmd.SetArgs([]string{filepath.Join("testdata", spec.file)})
+
+			require.NoError(t, cmd.Execute())
+			require.Equal(t, contractCodeHash+"\n", stdout.String())
+			if spec.expWarning {
+				require.Contains(t, stderr.String(), "isn't gzip compressed")
+			} else {
+				require.Empty(t, stderr.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// errNotTxSender is returned when a tx input was encrypted with a different tx key than the local one
+var errNotTxSender = errors.New("cannot decrypt, not original tx sender")
+
+// decryptTx decrypts the inputs, outputs, logs and error of a tx using the local tx encryption key.
+// Only the original sender of the tx holds the key, so the decryption never leaves the client.
+func decryptTx(wasmCtx wasmUtils.WASMContext, result *sdk.TxResponse) (*types.DecryptedAnswers, error) {
+	txInputs := result.GetTx().GetMsgs()
+
+	_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
+	}
+
+	answers := types.DecryptedAnswers{
+		Answers:        make([]*types.DecryptedAnswer, len(txInputs)),
+		OutputLogs:     []sdk.StringEvent{},
+		OutputError:    "",
+		PlaintextError: "",
+	}
+	nonces := make([][]byte, len(txInputs))
+
+	for i, tx := range txInputs {
+		var encryptedInput []byte
+		answers.Answers[i] = &types.DecryptedAnswer{}
+
+		switch txInput := tx.(type) {
+		case *types.MsgExecuteContract:
+			{
+				encryptedInput = txInput.Msg
+				answers.Answers[i].Type = "execute"
+			}
+		case *types.MsgInstantiateContract:
+			{
+				encryptedInput = txInput.InitMsg
+				answers.Answers[i].Type = "instantiate"
+			}
+		}
+
+		if encryptedInput != nil {
+			nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(encryptedInput)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse encrypted blob: %w", err)
+			}
+
+			if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
+				return nil, fmt.Errorf("%w: message %d was encrypted with tx key %X, but the local tx key is %X. "+
+					"Make sure to use the home directory of the account that sent the tx", errNotTxSender, i, originalTxSenderPubkey, myPubkey)
+			}
+
+			var plaintextInput []byte
+			if len(ciphertextInput) > 0 {
+				plaintextInput, err = wasmCtx.Decrypt(ciphertextInput, nonce)
+				if err != nil {
+					return nil, fmt.Errorf("error while trying to decrypt the tx input: %w", err)
+				}
+			}
+
+			answers.Answers[i].Input = string(plaintextInput)
+			nonces[i] = nonce
+		}
+	}
+
+	dataOutputHexB64 := result.Data
+	if dataOutputHexB64 != "" {
+		dataOutputAsProtobuf, err := hex.DecodeString(dataOutputHexB64)
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to decode the encrypted output data from hex string: %w", err)
+		}
+
+		var txData sdk.TxMsgData
+		err = proto.Unmarshal(dataOutputAsProtobuf, &txData)
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to parse data as protobuf: %w: %s", err, dataOutputHexB64)
+		}
+
+		for i, msgData := range txData.Data {
+			if len(msgData.Data) != 0 {
+				var dataField []byte
+				switch {
+				case msgData.MsgType == "/secret.compute.v1beta1.MsgInstantiateContract":
+					var msgResponse types.MsgInstantiateContractResponse
+					err := proto.Unmarshal(msgData.Data, &msgResponse)
+					if err != nil {
+						continue
+					}
+
+					dataField = msgResponse.Data
+				case msgData.MsgType == "/secret.compute.v1beta1.MsgExecuteContract":
+					var msgResponse types.MsgExecuteContractResponse
+					err := proto.Unmarshal(msgData.Data, &msgResponse)
+					if err != nil {
+						continue
+					}
+
+					dataField = msgResponse.Data
+				default:
+					continue
+				}
+
+				dataPlaintextB64Bz, err := wasmCtx.Decrypt(dataField, nonces[i])
+				if err != nil {
+					continue
+				}
+				dataPlaintextB64 := string(dataPlaintextB64Bz)
+				answers.Answers[i].OutputData = dataPlaintextB64
+
+				dataPlaintext, err := base64.StdEncoding.DecodeString(dataPlaintextB64)
+				if err != nil {
+					continue
+				}
+
+				answers.Answers[i].OutputDataAsString = string(dataPlaintext)
+			}
+		}
+	}
+
+	// decrypt logs
+	answers.OutputLogs = []sdk.StringEvent{}
+	for _, l := range result.Logs {
+		for _, e := range l.Events {
+			if e.Type == "wasm" {
+				for i, a := range e.Attributes {
+					if a.Key != "contract_address" {
+						// key
+						if a.Key != "" {
+							// Try to decrypt the log key. If it doesn't look encrypted, leave it as-is
+							keyCiphertext, err := base64.StdEncoding.DecodeString(a.Key)
+							if err != nil {
+								continue
+							}
+
+							for _, nonce := range nonces {
+								keyPlaintext, err := wasmCtx.Decrypt(keyCiphertext, nonce)
+								if err != nil {
+									continue
+								}
+								a.Key = string(keyPlaintext)
+								break
+							}
+						}
+
+						// value
+						if a.Value != "" {
+							// Try to decrypt the log value. If it doesn't look encrypted, leave it as-is
+							valueCiphertext, err := base64.StdEncoding.DecodeString(a.Value)
+							if err != nil {
+								continue
+							}
+							for _, nonce := range nonces {
+								valuePlaintext, err := wasmCtx.Decrypt(valueCiphertext, nonce)
+								if err != nil {
+									continue
+								}
+								a.Value = string(valuePlaintext)
+								break
+							}
+						}
+
+						e.Attributes[i] = a
+					}
+				}
+				answers.OutputLogs = append(answers.OutputLogs, e)
+			}
+		}
+	}
+
+	if types.IsEncryptedErrorCode(result.Code) && types.ContainsEncryptedString(result.RawLog) {
+		for i, nonce := range nonces {
+			stdErr, err := wasmCtx.DecryptError(result.RawLog, nonce)
+			if err != nil {
+				continue
+			}
+			answers.OutputError = string(append(json.RawMessage(fmt.Sprintf("message index %d: ", i)), stdErr...))
+			break
+		}
+	} else if types.ContainsEnclaveError(result.RawLog) {
+		answers.PlaintextError = result.RawLog
+	}
+
+	return &answers, nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	eng "github.com/scrtlabs/SecretNetwork/types"
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	reg "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+func init() {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(eng.Bech32PrefixAccAddr, eng.Bech32PrefixAccPub)
+}
+
+// loadTxFixture reads a tx recorded with `secretd query tx --output json`, together with
+// the master io key of the chain it was sent to
+func loadTxFixture(t *testing.T) (*sdk.TxResponse, reg.MasterKey) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	txtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := os.ReadFile(filepath.Join("testdata", "decrypt_tx.json"))
+	require.NoError(t, err)
+
+	var result sdk.TxResponse
+	require.NoError(t, cdc.UnmarshalJSON(bz, &result))
+	require.NoError(t, result.UnpackInterfaces(registry))
+
+	masterKeyB64, err := os.ReadFile(filepath.Join("testdata", "master_io_key.txt"))
+	require.NoError(t, err)
+	masterKey, err := base64.StdEncoding.DecodeString(string(masterKeyB64))
+	require.NoError(t, err)
+
+	return &result, reg.MasterKey{Bytes: masterKey}
+}
+
+func TestDecryptTx(t *testing.T) {
+	result, masterKey := loadTxFixture(t)
+
+	wasmCtx := wasmUtils.WASMContext{
+		TestKeyPairPath: filepath.Join("testdata", "id_tx_io.json"),
+		TestMasterIOKey: masterKey,
+	}
+
+	answers, err := decryptTx(wasmCtx, result)
+	require.NoError(t, err)
+
+	require.Len(t, answers.Answers, 1)
+	require.Equal(t, "execute", answers.Answers[0].Type)
+	require.Contains(t, answers.Answers[0].Input, `{"increment":{}}`)
+	require.Equal(t, `{"count":1}`, answers.Answers[0].OutputDataAsString)
+
+	require.Len(t, answers.OutputLogs, 1)
+	require.Contains(t, answers.OutputLogs[0].Attributes, sdk.Attribute{Key: "action", Value: "increment"})
+	require.Empty(t, answers.OutputError)
+}
+
+func TestDecryptTxNotSender(t *testing.T) {
+	result, masterKey := loadTxFixture(t)
+
+	wasmCtx := wasmUtils.WASMContext{
+		TestKeyPairPath: filepath.Join("testdata", "other_id_tx_io.json"),
+		TestMasterIOKey: masterKey,
+	}
+
+	_, err := decryptTx(wasmCtx, result)
+	require.ErrorIs(t, err, errNotTxSender)
+	require.Contains(t, err.Error(), "home directory of the account that sent the tx")
+}
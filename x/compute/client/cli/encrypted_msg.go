@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// encryptedMsg is the encrypted input of a contract message as it was sent on chain
+type encryptedMsg struct {
+	Type string `json:"type"`
+	// Nonce and TxSenderPubkey are the prefix of the encrypted input, both are needed to derive
+	// the key the ciphertext was encrypted with
+	Nonce          []byte `json:"nonce"`
+	TxSenderPubkey []byte `json:"tx_sender_pubkey"`
+	Ciphertext     []byte `json:"ciphertext"`
+	// CodeHash is the hash of the code the message was sent to, see codeHashIn
+	CodeHash string `json:"code_hash"`
+}
+
+// extractEncryptedMsg parses the encrypted input of message msgIndex of a tx. codeHash is asked
+// for the code hash of the message's target, nothing is decrypted.
+func extractEncryptedMsg(result *sdk.TxResponse, msgIndex int, codeHash func(sdk.Msg) (string, error)) (*encryptedMsg, error) {
+	msgs := result.GetTx().GetMsgs()
+	if msgIndex < 0 || msgIndex >= len(msgs) {
+		return nil, fmt.Errorf("message index %d is out of range, the tx has %d messages", msgIndex, len(msgs))
+	}
+
+	msg := msgs[msgIndex]
+	var (
+		msgType        string
+		encryptedInput []byte
+	)
+	switch m := msg.(type) {
+	case *types.MsgInstantiateContract:
+		msgType, encryptedInput = "instantiate", m.InitMsg
+	case *types.MsgExecuteContract:
+		msgType, encryptedInput = "execute", m.Msg
+	case *types.MsgMigrateContract:
+		msgType, encryptedInput = "migrate", m.Msg
+	default:
+		return nil, fmt.Errorf("message %d is a %s, not a contract message with an encrypted input", msgIndex, sdk.MsgTypeURL(msg))
+	}
+
+	nonce, txSenderPubkey, ciphertext, err := parseEncryptedBlob(encryptedInput)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse encrypted input of message %d: %w", msgIndex, err)
+	}
+
+	hash, err := codeHash(msg)
+	if err != nil {
+		return nil, fmt.Errorf("code hash of message %d: %w", msgIndex, err)
+	}
+
+	return &encryptedMsg{
+		Type:           msgType,
+		Nonce:          nonce,
+		TxSenderPubkey: txSenderPubkey,
+		Ciphertext:     ciphertext,
+		CodeHash:       hash,
+	}, nil
+}
+
+// codeHashIn returns the code hash of the target of a contract message. The code id of instantiate
+// and migrate messages is part of the message, execute messages use the code the contract runs at
+// the height queryClient queries at, which must be the height of the tx: the contract may have been
+// migrated since.
+func codeHashIn(queryClient types.QueryClient) func(sdk.Msg) (string, error) {
+	return func(msg sdk.Msg) (string, error) {
+		switch m := msg.(type) {
+		case *types.MsgInstantiateContract:
+			res, err := queryClient.CodeHashByCodeId(context.Background(), &types.QueryByCodeIdRequest{CodeId: m.CodeID})
+			if err != nil {
+				return "", err
+			}
+			return res.CodeHash, nil
+		case *types.MsgMigrateContract:
+			res, err := queryClient.CodeHashByCodeId(context.Background(), &types.QueryByCodeIdRequest{CodeId: m.CodeID})
+			if err != nil {
+				return "", err
+			}
+			return res.CodeHash, nil
+		case *types.MsgExecuteContract:
+			res, err := queryClient.CodeHashByContractAddress(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: m.Contract.String()})
+			if err != nil {
+				return "", err
+			}
+			return res.CodeHash, nil
+		default:
+			return "", fmt.Errorf("%s is not a contract message", sdk.MsgTypeURL(msg))
+		}
+	}
+}
+
+// GetCmdQueryEncryptedMsg prints the encrypted input of a contract message of a past tx
+func GetCmdQueryEncryptedMsg() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encrypted-msg [hash] [msg_index]",
+		Short: "Print the nonce, ciphertext and code hash of a contract message in a committed tx",
+		Long: "Print the nonce, tx sender public key and ciphertext of the encrypted input of a contract message " +
+			"(instantiate, execute or migrate) in a committed tx, together with the code hash of its target. " +
+			"Nothing is decrypted. For execute messages the code hash of the contract at the height of the tx is printed, " +
+			"which needs a node that still has the state of that height.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgIndex, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("msg_index: %w", err)
+			}
+
+			result, err := authtx.QueryTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			if result.Empty() {
+				return fmt.Errorf("no transaction found with hash %s", args[0])
+			}
+
+			// execute messages target the code their contract ran when the tx was included
+			clientCtx = clientCtx.WithHeight(result.Height)
+			msg, err := extractEncryptedMsg(result, msgIndex, codeHashIn(types.NewQueryClient(clientCtx)))
+			if err != nil {
+				return err
+			}
+
+			jsonBz, err := json.MarshalIndent(msg, "", "    ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(jsonBz) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
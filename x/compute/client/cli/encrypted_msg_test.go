@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestExtractEncryptedMsg(t *testing.T) {
+	sender := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	contract := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	nonce := bytes.Repeat([]byte{0x3}, 32)
+	txSenderPubkey := bytes.Repeat([]byte{0x4}, 32)
+	encrypted := func(ciphertext string) []byte {
+		return append(append(append([]byte{}, nonce...), txSenderPubkey...), ciphertext...)
+	}
+
+	msgs := []sdk.Msg{
+		&types.MsgInstantiateContract{Sender: sender, CodeID: 1, Label: "label", InitMsg: encrypted("init")},
+		&banktypes.MsgSend{FromAddress: sender.String(), ToAddress: contract.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1))},
+		&types.MsgExecuteContract{Sender: sender, Contract: contract, Msg: encrypted("execute")},
+		&types.MsgExecuteContract{Sender: sender, Contract: contract, Msg: []byte("too short")},
+	}
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = any
+	}
+	tx, err := codectypes.NewAnyWithValue(&txtypes.Tx{Body: &txtypes.TxBody{Messages: anys}})
+	require.NoError(t, err)
+	result := &sdk.TxResponse{Tx: tx}
+
+	codeHash := func(msg sdk.Msg) (string, error) {
+		switch m := msg.(type) {
+		case *types.MsgInstantiateContract:
+			return "code hash of code 1", nil
+		case *types.MsgExecuteContract:
+			require.Equal(t, contract, m.Contract)
+			return "code hash of contract", nil
+		default:
+			return "", errors.New("unexpected message")
+		}
+	}
+
+	specs := map[string]struct {
+		msgIndex int
+		exp      *encryptedMsg
+		expError string
+	}{
+		"instantiate": {
+			msgIndex: 0,
+			exp: &encryptedMsg{
+				Type:           "instantiate",
+				Nonce:          nonce,
+				TxSenderPubkey: txSenderPubkey,
+				Ciphertext:     []byte("init"),
+				CodeHash:       "code hash of code 1",
+			},
+		},
+		"execute": {
+			msgIndex: 2,
+			exp: &encryptedMsg{
+				Type:           "execute",
+				Nonce:          nonce,
+				TxSenderPubkey: txSenderPubkey,
+				Ciphertext:     []byte("execute"),
+				CodeHash:       "code hash of contract",
+			},
+		},
+		"not a contract message": {
+			msgIndex: 1,
+			expError: "message 1 is a /cosmos.bank.v1beta1.MsgSend, not a contract message",
+		},
+		"input too short": {
+			msgIndex: 3,
+			expError: "can't parse encrypted input of message 3",
+		},
+		"index out of range": {
+			msgIndex: 4,
+			expError: "message index 4 is out of range, the tx has 4 messages",
+		},
+		"negative index": {
+			msgIndex: -1,
+			expError: "out of range",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			msg, err := extractEncryptedMsg(result, spec.msgIndex, codeHash)
+			if spec.expError != "" {
+				require.ErrorContains(t, err, spec.expError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.exp, msg)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// ImportContractStateCmd returns the import-contract-state cobra Command.
+func ImportContractStateCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-contract-state [snapshot_file]",
+		Short: "Replace the state of a contract in genesis.json with an exported snapshot",
+		Long: `Replace the state of a contract in genesis.json with a snapshot written by
+"query compute export-contract-state". The contract must already be part of the genesis file
+and run the same code id as in the snapshot. Values are imported as they were exported, so the
+contract's encryption keys in genesis.json must match the ones the snapshot was taken with.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			header, entries, err := importContractStateSnapshot(cdc, config.GenesisFile(), args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("imported %d entries of contract %s (height %d)\n", entries, header.ContractAddress, header.Height))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// importContractStateSnapshot replaces the state of the snapshot's contract in genFile with the snapshot's entries.
+// The entries are streamed from the snapshot into the new genesis file, so they are never all held in memory:
+// the genesis file is first written with a placeholder entry in the contract's state, which is then replaced
+// by the snapshot entries while the file is written out.
+func importContractStateSnapshot(cdc codec.JSONCodec, genFile string, snapshotPath string) (contractStateSnapshotHeader, uint64, error) {
+	// the first pass only checks the snapshot, so a bad entry fails before genesis.json is touched
+	var entries uint64
+	header, err := readContractStateSnapshot(snapshotPath, func(model types.Model) error {
+		if err := model.ValidateBasic(); err != nil {
+			return fmt.Errorf("snapshot entry %d: %w", entries, err)
+		}
+		entries++
+		return nil
+	})
+	if err != nil {
+		return header, 0, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	contractAddress, err := sdk.AccAddressFromBech32(header.ContractAddress)
+	if err != nil {
+		return header, 0, fmt.Errorf("snapshot contract address: %w", err)
+	}
+
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return header, 0, fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	var computeGenState types.GenesisState
+	if err := cdc.UnmarshalJSON(appState[types.ModuleName], &computeGenState); err != nil {
+		return header, 0, fmt.Errorf("failed to unmarshal compute genesis state: %w", err)
+	}
+
+	contract := findGenesisContract(computeGenState.Contracts, contractAddress)
+	if contract == nil {
+		return header, 0, fmt.Errorf("contract %s is not part of the genesis file", header.ContractAddress)
+	}
+	if contract.ContractInfo.CodeID != header.CodeID {
+		return header, 0, fmt.Errorf("contract %s runs code id %d in the genesis file, but the snapshot was taken with code id %d", header.ContractAddress, contract.ContractInfo.CodeID, header.CodeID)
+	}
+
+	placeholder := types.Model{Key: make([]byte, 32)}
+	if _, err := rand.Read(placeholder.Key); err != nil {
+		return header, 0, err
+	}
+	contract.ContractState = []types.Model{placeholder}
+
+	if err := computeGenState.ValidateBasic(); err != nil {
+		return header, 0, fmt.Errorf("invalid compute genesis state: %w", err)
+	}
+
+	computeGenStateBz, err := cdc.MarshalJSON(&computeGenState)
+	if err != nil {
+		return header, 0, fmt.Errorf("failed to marshal compute genesis state: %w", err)
+	}
+
+	appState[types.ModuleName] = computeGenStateBz
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return header, 0, fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	if err := genDoc.ValidateAndComplete(); err != nil {
+		return header, 0, err
+	}
+	genDocBz, err := tmjson.MarshalIndent(genDoc, "", "  ")
+	if err != nil {
+		return header, 0, err
+	}
+
+	// the placeholder's key is random, so it is the only entry of the state array that contains it
+	placeholderKey, err := json.Marshal(placeholder.Key)
+	if err != nil {
+		return header, 0, err
+	}
+	keyPos := bytes.Index(genDocBz, placeholderKey)
+	if keyPos < 0 {
+		return header, 0, fmt.Errorf("contract state placeholder missing from the genesis file")
+	}
+	stateStart := bytes.LastIndexByte(genDocBz[:keyPos], '[') + 1
+	stateEnd := keyPos + bytes.IndexByte(genDocBz[keyPos:], ']')
+
+	// write next to genFile first, so a failure doesn't leave a truncated genesis file behind
+	tmpFile, err := os.CreateTemp(filepath.Dir(genFile), filepath.Base(genFile)+".*.tmp")
+	if err != nil {
+		return header, 0, err
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+	if err := tmpFile.Chmod(0o644); err != nil {
+		return header, 0, err
+	}
+
+	w := bufio.NewWriter(tmpFile)
+	if _, err := w.Write(genDocBz[:stateStart]); err != nil {
+		return header, 0, err
+	}
+	var written uint64
+	_, err = readContractStateSnapshot(snapshotPath, func(model types.Model) error {
+		if written > 0 {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		bz, err := json.Marshal(model)
+		if err != nil {
+			return err
+		}
+		written++
+		_, err = w.Write(bz)
+		return err
+	})
+	if err != nil {
+		return header, 0, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	if written != entries {
+		return header, 0, fmt.Errorf("snapshot changed while importing: read %d entries, then %d", entries, written)
+	}
+	if _, err := w.Write(genDocBz[stateEnd:]); err != nil {
+		return header, 0, err
+	}
+	if err := w.Flush(); err != nil {
+		return header, 0, err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return header, 0, err
+	}
+	if err := os.Rename(tmpFile.Name(), genFile); err != nil {
+		return header, 0, err
+	}
+
+	return header, entries, nil
+}
+
+func findGenesisContract(contracts []types.Contract, contractAddress sdk.AccAddress) *types.Contract {
+	for i := range contracts {
+		if contracts[i].ContractAddress.Equals(contractAddress) {
+			return &contracts[i]
+		}
+	}
+	return nil
+}
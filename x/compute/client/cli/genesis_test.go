@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestImportContractStateSnapshot(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	state := []types.Model{
+		{Key: []byte{0x0, 0x1}, Value: []byte("encrypted count")},
+		{Key: []byte("config"), Value: []byte("encrypted config")},
+		{Key: []byte("foo"), Value: []byte("encrypted bar")},
+	}
+
+	specs := map[string]struct {
+		header contractStateSnapshotHeader
+		state  []types.Model
+		expErr bool
+	}{
+		"contract state": {
+			header: contractStateSnapshotHeader{ContractAddress: contractAddr.String(), CodeID: 1, Label: "any", Height: 5},
+			state:  state,
+		},
+		"empty contract state": {
+			header: contractStateSnapshotHeader{ContractAddress: contractAddr.String(), CodeID: 1, Label: "any", Height: 5},
+			state:  []types.Model{},
+		},
+		"other code id": {
+			header: contractStateSnapshotHeader{ContractAddress: contractAddr.String(), CodeID: 2, Label: "any", Height: 5},
+			state:  state,
+			expErr: true,
+		},
+		"unknown contract": {
+			header: contractStateSnapshotHeader{ContractAddress: sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String(), CodeID: 1, Label: "any", Height: 5},
+			state:  state,
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			dir := t.TempDir()
+
+			genState := types.GenesisFixture(func(s *types.GenesisState) {
+				s.Contracts[0].ContractAddress = contractAddr
+				s.Contracts[1].ContractAddress = otherAddr
+			})
+			appState, err := json.Marshal(map[string]json.RawMessage{types.ModuleName: cdc.MustMarshalJSON(&genState)})
+			require.NoError(t, err)
+			genFile := filepath.Join(dir, "genesis.json")
+			genDoc := tmtypes.GenesisDoc{ChainID: "testing", AppState: appState}
+			require.NoError(t, genDoc.SaveAs(genFile))
+			genBefore, err := os.ReadFile(genFile)
+			require.NoError(t, err)
+
+			snapshotFile := filepath.Join(dir, "snapshot.jsonl")
+			file, err := os.Create(snapshotFile)
+			require.NoError(t, err)
+			sw, err := newContractStateSnapshotWriter(file, spec.header)
+			require.NoError(t, err)
+			for _, model := range spec.state {
+				require.NoError(t, sw.Write(model))
+			}
+			require.NoError(t, sw.Flush())
+			require.NoError(t, file.Close())
+
+			header, entries, err := importContractStateSnapshot(cdc, genFile, snapshotFile)
+			if spec.expErr {
+				require.Error(t, err)
+				genAfter, err := os.ReadFile(genFile)
+				require.NoError(t, err)
+				require.Equal(t, genBefore, genAfter)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.header, header)
+			require.Equal(t, uint64(len(spec.state)), entries)
+
+			appStateAfter, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			require.NoError(t, err)
+			var genStateAfter types.GenesisState
+			require.NoError(t, cdc.UnmarshalJSON(appStateAfter[types.ModuleName], &genStateAfter))
+			require.NoError(t, genStateAfter.ValidateBasic())
+
+			// everything but the contract's state is left as it was
+			expState := genState
+			expState.Contracts[0].ContractState = spec.state
+			require.JSONEq(t, string(cdc.MustMarshalJSON(&expState)), string(cdc.MustMarshalJSON(&genStateAfter)))
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
-	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
@@ -19,12 +21,16 @@ import (
 	cosmwasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	flag "github.com/spf13/pflag"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/scrtlabs/SecretNetwork/types/util"
 	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
 
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
@@ -42,20 +48,496 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdListCode(),
+		GetCmdQueryCodesCount(),
 		GetCmdListContractByCode(),
+		GetCmdQueryContractsCount(),
+		GetCmdListContractsByCreator(),
 		GetCmdQueryCode(),
 		GetCmdGetContractInfo(),
 		GetCmdQuery(),
 		GetQueryDecryptTxCmd(),
+		GetCmdQueryEncryptedMsg(),
 		GetCmdQueryLabel(),
 		GetCmdCodeHashByContractAddress(),
 		GetCmdCodeHashByCodeID(),
+		GetCmdCodeHashOfFile(),
+		GetCmdVerifyCodeSource(),
 		CmdDecryptText(),
 		GetCmdGetContractHistory(),
+		GetCmdQueryContractStateByKey(),
+		GetCmdQueryContractStateRange(),
+		GetCmdQueryContractStorageUsage(),
+		GetCmdQueryContractIbcPortID(),
+		GetCmdQueryContractIbcChannels(),
+		GetCmdQueryContractPortfolio(),
+		GetCmdQueryContractEventsByAttribute(),
+		GetCmdQueryResultByCorrelationID(),
+		GetCmdExportContractState(),
+		GetCmdPredictAddress(),
+		GetCmdQueryPendingMigrations(),
 	)
 	return queryCmd
 }
 
+// GetCmdQueryContractStateByKey returns the encrypted value stored under a raw key of a contract's state
+func GetCmdQueryContractStateByKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-state-by-key [address] [hex_key]",
+		Short: "Return the raw value stored under a key of a contract's state",
+		Long:  "Return the raw value stored under a hex encoded key of a contract's state. Contract state is encrypted by the enclave, so the value is ciphertext",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return fmt.Errorf("key: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStateByKey(
+				context.Background(),
+				&types.QueryContractStateByKeyRequest{
+					ContractAddress: args[0],
+					Key:             key,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractStateRange returns a page of a contract's raw state within a key range
+func GetCmdQueryContractStateRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-state-range [address]",
+		Short: "Return a page of a contract's raw state within a key range",
+		Long: "Return a page of the keys of a contract's raw state that start with --prefix and fall within [--start, --end), " +
+			"ordered by key. Keys are hex encoded. Contract state is encrypted by the enclave, so the values are ciphertext",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var keys [3][]byte
+			for i, flagName := range []string{flagPrefix, flagStart, flagEnd} {
+				hexKey, err := cmd.Flags().GetString(flagName)
+				if err != nil {
+					return err
+				}
+				keys[i], err = hex.DecodeString(strings.TrimPrefix(hexKey, "0x"))
+				if err != nil {
+					return fmt.Errorf("%s: %s", flagName, err)
+				}
+			}
+
+			pageReq, err := util.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStateRange(
+				context.Background(),
+				&types.QueryContractStateRangeRequest{
+					ContractAddress: args[0],
+					Prefix:          keys[0],
+					Start:           keys[1],
+					End:             keys[2],
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String(flagPrefix, "", "Only return keys starting with this hex encoded prefix")
+	cmd.Flags().String(flagStart, "", "Hex encoded first key of the range (inclusive)")
+	cmd.Flags().String(flagEnd, "", "Hex encoded last key of the range (exclusive)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract state range")
+	return cmd
+}
+
+// GetCmdQueryContractStorageUsage returns the number of bytes a contract holds in its state
+func GetCmdQueryContractStorageUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-storage-usage [address]",
+		Short: "Return the number of bytes a contract holds in its state",
+		Long:  "Return the number of bytes (keys and values) a contract holds in its state, and the per-contract storage limit (0 means unlimited)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorageUsage(
+				context.Background(),
+				&types.QueryByContractAddressRequest{
+					ContractAddress: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractIbcPortID returns the IBC port bound to a contract
+func GetCmdQueryContractIbcPortID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-ibc-port-id [address]",
+		Short: "Return the IBC port bound to a contract",
+		Long:  "Return the IBC port bound to a contract. The port is empty if the contract has no IBC entry points",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractIbcPortId(
+				context.Background(),
+				&types.QueryByContractAddressRequest{
+					ContractAddress: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractPortfolio returns the balance, delegations and unbonding tokens of a contract
+func GetCmdQueryContractPortfolio() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-portfolio [address]",
+		Short: "Return the balance, delegated and unbonding tokens of a contract",
+		Long:  "Return the bank balance of a contract together with the tokens it has delegated to validators and the tokens it's unbonding",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractPortfolio(
+				context.Background(),
+				&types.QueryByContractAddressRequest{
+					ContractAddress: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractIbcChannels returns the open IBC channels of a contract
+func GetCmdQueryContractIbcChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-ibc-channels [address]",
+		Short: "Return the open IBC channels of a contract",
+		Long:  "Return the open IBC channels on the port bound to a contract, empty if the contract has no IBC entry points",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractIbcChannels(
+				context.Background(),
+				&types.QueryByContractAddressRequest{
+					ContractAddress: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractEventsByAttribute returns the indexed event attributes a contract emitted with a key
+func GetCmdQueryContractEventsByAttribute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-events [address] [key]",
+		Short: "Return the indexed event attributes a contract emitted with a key",
+		Long: `Return the event attributes a contract emitted with the key, oldest first. Only plaintext
+attributes the contract marked for indexing with an "indexed:" key prefix are returned, the key is
+given without the prefix. Only the 10000 most recent attributes of a contract are kept.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			value, err := cmd.Flags().GetString(flagValue)
+			if err != nil {
+				return err
+			}
+			pageReq, err := util.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractEventsByAttribute(
+				context.Background(),
+				&types.QueryContractEventsByAttributeRequest{
+					ContractAddress: args[0],
+					Key:             args[1],
+					Value:           value,
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String(flagValue, "", "Only return attributes with this value")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract events")
+	return cmd
+}
+
+// GetCmdQueryResultByCorrelationID returns the result of an execution a sender tagged with a correlation id
+func GetCmdQueryResultByCorrelationID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "result-by-correlation-id [sender] [correlation_id]",
+		Short: "Return the result of an execution a sender tagged with a correlation id",
+		Long: `Return the contract, height and result data of an execution the sender tagged with
+--correlation-id. The data is encrypted for the sender like in the tx result.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ResultByCorrelationId(
+				context.Background(),
+				&types.QueryResultByCorrelationIdRequest{
+					SenderAddress: args[0],
+					CorrelationId: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExportContractState writes the full raw state of a contract to a snapshot file
+func GetCmdExportContractState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-contract-state [address] [output_file]",
+		Short: "Export the full raw state of a contract to a file",
+		Long: `Export the full raw state of a contract to a file, for forensics or to be imported into
+a genesis file with import-contract-state. The first line of the file holds the contract's address,
+code id, label and the height the state was read at; every following line is one key/value pair,
+in ascending key order. Values are exported as stored on chain, i.e. encrypted by the enclave.
+The state is fetched page by page at a single height, so the export is consistent.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageLimit, err := cmd.Flags().GetUint64(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// pin all following queries to the height the contract info was read at
+			var header metadata.MD
+			infoRes, err := queryClient.ContractInfo(
+				context.Background(),
+				&types.QueryByContractAddressRequest{
+					ContractAddress: args[0],
+				},
+				grpc.Header(&header),
+			)
+			if err != nil {
+				return err
+			}
+			height := clientCtx.Height
+			if heights := header.Get(grpctypes.GRPCBlockHeightHeader); height == 0 && len(heights) == 1 {
+				height, err = strconv.ParseInt(heights[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid block height header: %s", err)
+				}
+				queryClient = types.NewQueryClient(clientCtx.WithHeight(height))
+			}
+
+			file, err := os.Create(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := newContractStateSnapshotWriter(file, contractStateSnapshotHeader{
+				ContractAddress: infoRes.ContractAddress,
+				CodeID:          infoRes.CodeID,
+				Label:           infoRes.Label,
+				Height:          height,
+			})
+			if err != nil {
+				return err
+			}
+
+			var nextKey []byte
+			for {
+				res, err := queryClient.ContractState(
+					context.Background(),
+					&types.QueryContractStateRequest{
+						ContractAddress: args[0],
+						Pagination:      &query.PageRequest{Key: nextKey, Limit: pageLimit},
+					},
+				)
+				if err != nil {
+					return err
+				}
+
+				for _, model := range res.Models {
+					if err := snapshot.Write(model); err != nil {
+						return err
+					}
+				}
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				nextKey = res.Pagination.NextKey
+			}
+
+			if err := snapshot.Flush(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("exported %d entries of contract %s at height %d to %s\n", snapshot.count, infoRes.ContractAddress, height, args[1]))
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().Uint64(flags.FlagLimit, 1000, "Number of state entries fetched per query")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPredictAddress returns the address of a contract instantiated with a salt
+func GetCmdPredictAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predict-address [code_id] [creator] [hex_salt]",
+		Short: "Return the address of a contract instantiated with a salt",
+		Long:  "Return the address a contract would get when instantiated from code_id by creator with --salt",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			salt, err := hex.DecodeString(strings.TrimPrefix(args[2], "0x"))
+			if err != nil {
+				return fmt.Errorf("salt: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PredictAddress(
+				context.Background(),
+				&types.QueryPredictAddressRequest{
+					CodeId:  codeID,
+					Creator: args[1],
+					Salt:    salt,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdListCode lists all wasm code uploaded
 func GetCmdListCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -83,6 +565,62 @@ func GetCmdListCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryCodesCount returns the number of codes stored on chain
+func GetCmdQueryCodesCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codes-count",
+		Short: "Return the number of wasm codes stored on the chain",
+		Long:  "Return the number of wasm codes stored on the chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodesCount(context.Background(), &empty.Empty{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractsCount returns the number of contracts instantiated on chain
+func GetCmdQueryContractsCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-count",
+		Short: "Return the number of contracts instantiated on the chain",
+		Long:  "Return the number of contracts instantiated on the chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsCount(context.Background(), &empty.Empty{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdListCode lists all wasm code uploaded
 func GetCmdQueryLabel() *cobra.Command {
 	cmd := &cobra.Command{
@@ -123,7 +661,7 @@ func GetCmdQueryLabel() *cobra.Command {
 	return cmd
 }
 
-// GetCmdListCode lists all wasm code uploaded
+// GetCmdCodeHashByContractAddress returns the code hash of a contract by address
 func GetCmdCodeHashByContractAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "contract-hash [address]",
@@ -142,6 +680,10 @@ func GetCmdCodeHashByContractAddress() *cobra.Command {
 				return fmt.Errorf("error querying contract hash: %s", err)
 			}
 
+			if len(res) == 0 {
+				return fmt.Errorf("contract with address %s not found", args[0])
+			}
+
 			addr := hex.EncodeToString(res)
 			fmt.Printf("0x%s\n", addr)
 			return nil
@@ -155,10 +697,11 @@ func GetCmdCodeHashByContractAddress() *cobra.Command {
 // GetCmdCodeHashByID return the code hash of a contract by ID
 func GetCmdCodeHashByCodeID() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "contract-hash-by-id [code_id]",
-		Short: "Return the code hash of a contract represented by ID",
-		Long:  "Return the code hash of a contract represented by ID",
-		Args:  cobra.ExactArgs(1),
+		Use:     "contract-hash-by-id [code_id]",
+		Aliases: []string{"code-hash"},
+		Short:   "Return the code hash of a contract represented by ID",
+		Long:    "Return the code hash of a contract represented by ID",
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -185,6 +728,47 @@ func GetCmdCodeHashByCodeID() *cobra.Command {
 	return cmd
 }
 
+// GetCmdVerifyCodeSource returns the source a code was stored with, to verify it against
+func GetCmdVerifyCodeSource() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "code-source [code_id]",
+		Aliases: []string{"verify-code-source"},
+		Short:   "Return the source, builder and source checksum a code was stored with",
+		Long: `Return the code hash, source, builder and source checksum (hex) a code was stored with.
+Rebuild the source with the builder and compare the result with the code hash, and hash the
+source archive and compare it with the source checksum, to verify the stored code.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VerifyCodeSource(
+				context.Background(),
+				&types.QueryByCodeIdRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdListContractByCode lists all wasm code uploaded for given code id
 func GetCmdListContractByCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -217,6 +801,50 @@ func GetCmdListContractByCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByCreator lists all contracts an address instantiated, one page at a time
+func GetCmdListContractsByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-creator [creator_address]",
+		Short: "List all contracts the given address instantiated",
+		Long:  "List all contracts the given address instantiated, ordered by the time they were instantiated",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := util.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByCreator(
+				context.Background(),
+				&types.QueryContractsByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts by creator")
+	return cmd
+}
+
 // GetCmdQueryCode returns the bytecode for a given contract
 func GetCmdQueryCode() *cobra.Command {
 	cmd := &cobra.Command{
@@ -265,10 +893,11 @@ func GetCmdQueryCode() *cobra.Command {
 // GetCmdGetContractInfo gets details about a given contract
 func GetCmdGetContractInfo() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "contract [bech32_address]",
-		Short: "Prints out metadata of a contract given its address",
-		Long:  "Prints out metadata of a contract given its address",
-		Args:  cobra.ExactArgs(1),
+		Use:     "contract [bech32_address]",
+		Aliases: []string{"info"},
+		Short:   "Prints out metadata of a contract given its address",
+		Long:    "Prints out the code id, creator, label, admin and creation height of a contract given its address",
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -280,14 +909,20 @@ func GetCmdGetContractInfo() *cobra.Command {
 				return err
 			}
 
-			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryGetContract, addr.String())
-			res, _, err := clientCtx.Query(route)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractInfo(
+				context.Background(),
+				&types.QueryByContractAddressRequest{
+					ContractAddress: addr.String(),
+				},
+			)
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(res))
-			return nil
+
+			return clientCtx.PrintProto(res)
 		},
+		SilenceUsage: true,
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
@@ -347,9 +982,12 @@ func CmdDecryptText() *cobra.Command {
 // Coppied from https://github.com/cosmos/cosmos-sdk/blob/v0.38.4/x/auth/client/cli/query.go#L157-L184 and added IO decryption (Could not wrap it because it prints directly to stdout)
 func GetQueryDecryptTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "tx [hash]",
-		Short: "Query for a transaction by hash in a committed block, decrypt input and outputs if I'm the tx sender",
-		Args:  cobra.ExactArgs(1),
+		Use:     "tx [hash]",
+		Aliases: []string{"decrypt-tx"},
+		Short:   "Query for a transaction by hash in a committed block, decrypt input and outputs if I'm the tx sender",
+		Long: "Query for a transaction by hash in a committed block and decrypt its inputs, outputs, logs and error " +
+			"with the tx encryption key stored in the home directory. The tx must have been sent with this key.",
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -365,177 +1003,10 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 				return fmt.Errorf("no transaction found with hash %s", args[0])
 			}
 
-			txInputs := result.GetTx().GetMsgs()
-
 			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
-			_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+			answers, err := decryptTx(wasmCtx, result)
 			if err != nil {
-				return fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
-			}
-
-			answers := types.DecryptedAnswers{
-				Answers:        make([]*types.DecryptedAnswer, len(txInputs)),
-				OutputLogs:     []sdk.StringEvent{},
-				OutputError:    "",
-				PlaintextError: "",
-			}
-			nonces := make([][]byte, len(txInputs))
-
-			for i, tx := range txInputs {
-				var encryptedInput []byte
-				answers.Answers[i] = &types.DecryptedAnswer{}
-
-				switch txInput := tx.(type) {
-				case *types.MsgExecuteContract:
-					{
-						encryptedInput = txInput.Msg
-						answers.Answers[i].Type = "execute"
-					}
-				case *types.MsgInstantiateContract:
-					{
-						encryptedInput = txInput.InitMsg
-						answers.Answers[i].Type = "instantiate"
-					}
-				}
-
-				if encryptedInput != nil {
-					nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(encryptedInput)
-					if err != nil {
-						return fmt.Errorf("can't parse encrypted blob: %w", err)
-					}
-
-					if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
-						return fmt.Errorf("cannot decrypt, not original tx sender")
-					}
-
-					var plaintextInput []byte
-					if len(ciphertextInput) > 0 {
-						plaintextInput, err = wasmCtx.Decrypt(ciphertextInput, nonce)
-						if err != nil {
-							return fmt.Errorf("error while trying to decrypt the tx input: %w", err)
-						}
-					}
-
-					answers.Answers[i].Input = string(plaintextInput)
-					nonces[i] = nonce
-				}
-			}
-
-			dataOutputHexB64 := result.Data
-			if dataOutputHexB64 != "" {
-				dataOutputAsProtobuf, err := hex.DecodeString(dataOutputHexB64)
-				if err != nil {
-					return fmt.Errorf("error while trying to decode the encrypted output data from hex string: %w", err)
-				}
-
-				var txData sdk.TxMsgData
-				err = proto.Unmarshal(dataOutputAsProtobuf, &txData)
-				if err != nil {
-					return fmt.Errorf("error while trying to parse data as protobuf: %w: %s", err, dataOutputHexB64)
-				}
-
-				for i, msgData := range txData.Data {
-					if len(msgData.Data) != 0 {
-						var dataField []byte
-						switch {
-						case msgData.MsgType == "/secret.compute.v1beta1.MsgInstantiateContract":
-							var msgResponse types.MsgInstantiateContractResponse
-							err := proto.Unmarshal(msgData.Data, &msgResponse)
-							if err != nil {
-								continue
-							}
-
-							dataField = msgResponse.Data
-						case msgData.MsgType == "/secret.compute.v1beta1.MsgExecuteContract":
-							var msgResponse types.MsgExecuteContractResponse
-							err := proto.Unmarshal(msgData.Data, &msgResponse)
-							if err != nil {
-								continue
-							}
-
-							dataField = msgResponse.Data
-						default:
-							continue
-						}
-
-						dataPlaintextB64Bz, err := wasmCtx.Decrypt(dataField, nonces[i])
-						if err != nil {
-							continue
-						}
-						dataPlaintextB64 := string(dataPlaintextB64Bz)
-						answers.Answers[i].OutputData = dataPlaintextB64
-
-						dataPlaintext, err := base64.StdEncoding.DecodeString(dataPlaintextB64)
-						if err != nil {
-							continue
-						}
-
-						answers.Answers[i].OutputDataAsString = string(dataPlaintext)
-					}
-				}
-			}
-
-			// decrypt logs
-			answers.OutputLogs = []sdk.StringEvent{}
-			for _, l := range result.Logs {
-				for _, e := range l.Events {
-					if e.Type == "wasm" {
-						for i, a := range e.Attributes {
-							if a.Key != "contract_address" {
-								// key
-								if a.Key != "" {
-									// Try to decrypt the log key. If it doesn't look encrypted, leave it as-is
-									keyCiphertext, err := base64.StdEncoding.DecodeString(a.Key)
-									if err != nil {
-										continue
-									}
-
-									for _, nonce := range nonces {
-										keyPlaintext, err := wasmCtx.Decrypt(keyCiphertext, nonce)
-										if err != nil {
-											continue
-										}
-										a.Key = string(keyPlaintext)
-										break
-									}
-								}
-
-								// value
-								if a.Value != "" {
-									// Try to decrypt the log value. If it doesn't look encrypted, leave it as-is
-									valueCiphertext, err := base64.StdEncoding.DecodeString(a.Value)
-									if err != nil {
-										continue
-									}
-									for _, nonce := range nonces {
-										valuePlaintext, err := wasmCtx.Decrypt(valueCiphertext, nonce)
-										if err != nil {
-											continue
-										}
-										a.Value = string(valuePlaintext)
-										break
-									}
-								}
-
-								e.Attributes[i] = a
-							}
-						}
-						answers.OutputLogs = append(answers.OutputLogs, e)
-					}
-				}
-			}
-
-			if types.IsEncryptedErrorCode(result.Code) && types.ContainsEncryptedString(result.RawLog) {
-				for i, nonce := range nonces {
-					stdErr, err := wasmCtx.DecryptError(result.RawLog, nonce)
-					if err != nil {
-						continue
-					}
-					answers.OutputError = string(append(json.RawMessage(fmt.Sprintf("message index %d: ", i)), stdErr...))
-					break
-				}
-			} else if types.ContainsEnclaveError(result.RawLog) {
-				answers.PlaintextError = result.RawLog
+				return err
 			}
 
 			jsonBz, err := json.MarshalIndent(answers, "", "    ")
@@ -734,11 +1205,17 @@ func GetCmdGetContractHistory() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := util.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.ContractHistory(
 				context.Background(),
 				&types.QueryContractHistoryRequest{
 					ContractAddress: args[0],
+					Pagination:      pageReq,
 				},
 			)
 			if err != nil {
@@ -755,19 +1232,40 @@ func GetCmdGetContractHistory() *cobra.Command {
 	return cmd
 }
 
-// sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
-func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
-	encoded, err := flagSet.GetString(flags.FlagPageKey)
-	if err != nil {
-		panic(err.Error())
-	}
-	raw, err := base64.StdEncoding.DecodeString(encoded)
-	if err != nil {
-		panic(err.Error())
-	}
-	err = flagSet.Set(flags.FlagPageKey, string(raw))
-	if err != nil {
-		panic(err.Error())
+func GetCmdQueryPendingMigrations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-migrations",
+		Short: "Lists the contract migrations that were proposed and weren't executed or canceled yet",
+		Long:  "Lists the contract migrations that were proposed and weren't executed or canceled yet",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := util.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingMigrations(
+				context.Background(),
+				&types.QueryPendingMigrationsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
 	}
-	return flagSet
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending migrations")
+	return cmd
 }
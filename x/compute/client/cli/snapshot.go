@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// contractStateSnapshotHeader is the first line of a contract state snapshot.
+// Every following line is a JSON encoded types.Model, in ascending key order.
+// Values are copied as stored on chain, i.e. they are still encrypted by the enclave.
+type contractStateSnapshotHeader struct {
+	ContractAddress string `json:"contract_address"`
+	CodeID          uint64 `json:"code_id"`
+	Label           string `json:"label"`
+	Height          int64  `json:"height"`
+}
+
+// contractStateSnapshotWriter writes a snapshot one line at a time, so large contracts
+// never have to be held in memory
+type contractStateSnapshotWriter struct {
+	w       *bufio.Writer
+	lastKey []byte
+	count   uint64
+}
+
+func newContractStateSnapshotWriter(w io.Writer, header contractStateSnapshotHeader) (*contractStateSnapshotWriter, error) {
+	sw := &contractStateSnapshotWriter{w: bufio.NewWriter(w)}
+	if err := sw.writeLine(header); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+func (sw *contractStateSnapshotWriter) Write(model types.Model) error {
+	if sw.count > 0 && bytes.Compare(model.Key, sw.lastKey) <= 0 {
+		return fmt.Errorf("keys out of order: %X after %X", model.Key, sw.lastKey)
+	}
+	sw.lastKey = model.Key
+	sw.count++
+	return sw.writeLine(model)
+}
+
+func (sw *contractStateSnapshotWriter) Flush() error {
+	return sw.w.Flush()
+}
+
+func (sw *contractStateSnapshotWriter) writeLine(v interface{}) error {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, err := sw.w.Write(bz); err != nil {
+		return err
+	}
+	return sw.w.WriteByte('\n')
+}
+
+// readContractStateSnapshot reads the snapshot at path, calling cb for every entry in file order.
+// Entries must be in strictly ascending key order.
+func readContractStateSnapshot(path string, cb func(types.Model) error) (contractStateSnapshotHeader, error) {
+	var header contractStateSnapshotHeader
+
+	file, err := os.Open(path)
+	if err != nil {
+		return header, err
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	if err := decoder.Decode(&header); err != nil {
+		return header, fmt.Errorf("snapshot header: %w", err)
+	}
+	if header.ContractAddress == "" {
+		return header, fmt.Errorf("snapshot header: missing contract address")
+	}
+
+	var lastKey []byte
+	for entry := 0; ; entry++ {
+		var model types.Model
+		err := decoder.Decode(&model)
+		if err == io.EOF {
+			return header, nil
+		}
+		if err != nil {
+			return header, fmt.Errorf("snapshot entry %d: %w", entry, err)
+		}
+		if entry > 0 && bytes.Compare(model.Key, lastKey) <= 0 {
+			return header, fmt.Errorf("snapshot entry %d: keys out of order: %X after %X", entry, model.Key, lastKey)
+		}
+		lastKey = model.Key
+
+		if err := cb(model); err != nil {
+			return header, err
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
@@ -25,14 +26,26 @@ const (
 	flagAmount                 = "amount"
 	flagSource                 = "source"
 	flagBuilder                = "builder"
+	flagSourceChecksum         = "source-checksum"
 	flagLabel                  = "label"
 	flagRunAs                  = "run-as"
 	flagInstantiateByEverybody = "instantiate-everybody"
+	flagInstantiateNobody      = "instantiate-nobody"
 	flagInstantiateByAddress   = "instantiate-only-address"
+	flagInstantiateByAnyOf     = "instantiate-anyof-addresses"
 	flagProposalType           = "type"
 	flagIoMasterKey            = "enclave-key"
 	flagCodeHash               = "code-hash"
 	flagAdmin                  = "admin"
+	flagSalt                   = "salt"
+	flagReentrancyProtected    = "reentrancy-protected"
+	flagSendWhitelist          = "send-whitelist"
+	flagCorrelationID          = "correlation-id"
+	flagReadOnly               = "read-only"
+	flagValue                  = "value"
+	flagPrefix                 = "prefix"
+	flagStart                  = "start"
+	flagEnd                    = "end"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -48,10 +61,15 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		StoreCodeCmd(),
 		InstantiateContractCmd(),
+		InstantiateContractBatchCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		PauseContractCmd(),
+		ResumeContractCmd(),
+		ProposeContractMigrationCmd(),
+		CancelContractMigrationCmd(),
 	)
 	return txCmd
 }
@@ -82,8 +100,11 @@ func StoreCodeCmd() *cobra.Command {
 
 	cmd.Flags().String(flagSource, "", "A valid URI reference to the contract's source code, optional")
 	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
+	cmd.Flags().String(flagSourceChecksum, "", "Hex encoded sha256 checksum of the source code, requires --source, optional")
 	cmd.Flags().String(flagInstantiateByEverybody, "", "Everybody can instantiate a contract from the code, optional")
+	cmd.Flags().String(flagInstantiateNobody, "", "Nobody can instantiate a contract from the code, optional")
 	cmd.Flags().String(flagInstantiateByAddress, "", "Only this address can instantiate a contract instance from the code, optional")
+	cmd.Flags().StringSlice(flagInstantiateByAnyOf, []string{}, "Any of the addresses can instantiate a contract from the code, optional")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -113,17 +134,106 @@ func parseStoreCodeArgs(args []string, cliCtx client.Context, flags *flag.FlagSe
 	if err != nil {
 		return types.MsgStoreCode{}, fmt.Errorf("builder: %s", err)
 	}
+	sourceChecksumHex, err := flags.GetString(flagSourceChecksum)
+	if err != nil {
+		return types.MsgStoreCode{}, fmt.Errorf("source checksum: %s", err)
+	}
+	var sourceChecksum []byte
+	if sourceChecksumHex != "" {
+		sourceChecksum, err = hex.DecodeString(sourceChecksumHex)
+		if err != nil {
+			return types.MsgStoreCode{}, fmt.Errorf("source checksum: %s", err)
+		}
+	}
+
+	instantiatePermission, err := parseAccessConfigFlags(flags)
+	if err != nil {
+		return types.MsgStoreCode{}, err
+	}
 
 	// build and sign the transaction, then broadcast to Tendermint
 	msg := types.MsgStoreCode{
-		Sender:       cliCtx.GetFromAddress(),
-		WASMByteCode: wasm,
-		Source:       source,
-		Builder:      builder,
+		Sender:                cliCtx.GetFromAddress(),
+		WASMByteCode:          wasm,
+		Source:                source,
+		Builder:               builder,
+		SourceChecksum:        sourceChecksum,
+		InstantiatePermission: instantiatePermission,
 	}
 	return msg, nil
 }
 
+// parseAccessConfigFlags returns the instantiate permission selected by the store flags,
+// or nil when none is set, which lets everybody instantiate
+func parseAccessConfigFlags(flags *flag.FlagSet) (*types.AccessConfig, error) {
+	var configs []types.AccessConfig
+
+	everybodyStr, err := flags.GetString(flagInstantiateByEverybody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by everybody: %s", err)
+	}
+	if everybodyStr != "" {
+		ok, err := strconv.ParseBool(everybodyStr)
+		if err != nil {
+			return nil, fmt.Errorf("boolean value expected for instantiate by everybody: %s", err)
+		}
+		if ok {
+			configs = append(configs, types.AllowEverybody)
+		}
+	}
+
+	nobodyStr, err := flags.GetString(flagInstantiateNobody)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by nobody: %s", err)
+	}
+	if nobodyStr != "" {
+		ok, err := strconv.ParseBool(nobodyStr)
+		if err != nil {
+			return nil, fmt.Errorf("boolean value expected for instantiate by nobody: %s", err)
+		}
+		if ok {
+			configs = append(configs, types.AllowNobody)
+		}
+	}
+
+	onlyAddrStr, err := flags.GetString(flagInstantiateByAddress)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by address: %s", err)
+	}
+	if onlyAddrStr != "" {
+		addr, err := sdk.AccAddressFromBech32(onlyAddrStr)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, flagInstantiateByAddress)
+		}
+		configs = append(configs, types.OnlyAddressAccess(addr))
+	}
+
+	anyOfAddrsStr, err := flags.GetStringSlice(flagInstantiateByAnyOf)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate by any of addresses: %s", err)
+	}
+	if len(anyOfAddrsStr) != 0 {
+		addrs := make([]sdk.AccAddress, len(anyOfAddrsStr))
+		for i, addrStr := range anyOfAddrsStr {
+			addr, err := sdk.AccAddressFromBech32(addrStr)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, flagInstantiateByAnyOf)
+			}
+			addrs[i] = addr
+		}
+		configs = append(configs, types.AnyOfAddressesAccess(addrs...))
+	}
+
+	switch len(configs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &configs[0], nil
+	default:
+		return nil, fmt.Errorf("only one of the instantiate permission flags can be set")
+	}
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -154,6 +264,9 @@ func InstantiateContractCmd() *cobra.Command {
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Optional: Bech32 address of the admin of the contract")
+	cmd.Flags().String(flagSalt, "", "Optional: hex encoded salt, instantiates the contract at a predictable address (see `query compute predict-address`)")
+	cmd.Flags().Bool(flagReentrancyProtected, false, "Optional: reject executions of the contract that start while it is already executing, e.g. from its own submessages")
+	cmd.Flags().StringSlice(flagSendWhitelist, nil, "Optional: comma separated bech32 addresses, the only addresses the contract can send funds to with bank messages")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -259,9 +372,164 @@ func parseInstantiateArgs(args []string, cliCtx client.Context, initFlags *flag.
 		msg.Admin = admin
 	}
 
+	saltStr, err := initFlags.GetString(flagSalt)
+	if err != nil {
+		return types.MsgInstantiateContract{}, fmt.Errorf("salt: %s", err)
+	}
+	if saltStr != "" {
+		msg.Salt, err = hex.DecodeString(strings.TrimPrefix(saltStr, "0x"))
+		if err != nil {
+			return types.MsgInstantiateContract{}, fmt.Errorf("salt: %s", err)
+		}
+	}
+
+	msg.ReentrancyProtected, err = initFlags.GetBool(flagReentrancyProtected)
+	if err != nil {
+		return types.MsgInstantiateContract{}, fmt.Errorf("reentrancy protected: %s", err)
+	}
+
+	msg.SendWhitelist, err = initFlags.GetStringSlice(flagSendWhitelist)
+	if err != nil {
+		return types.MsgInstantiateContract{}, fmt.Errorf("send whitelist: %s", err)
+	}
+
 	return msg, nil
 }
 
+// batchInstance is a single entry of the batch file consumed by InstantiateContractBatchCmd
+type batchInstance struct {
+	Label   string          `json:"label"`
+	InitMsg json.RawMessage `json:"init_msg"`
+	Amount  string          `json:"amount,omitempty"`
+}
+
+// InstantiateContractBatchCmd instantiates many contracts from the same code ID in a single transaction.
+// Every instance is a regular MsgInstantiateContract so the enclave can verify it against the signed tx,
+// and since a tx is atomic, if one instantiation fails none of them are stored.
+func InstantiateContractBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-batch [code_id_int64] [json_file] --admin [admin_addr_bech32,optional]",
+		Short: "Instantiate many wasm contracts from the same code in one transaction",
+		Long: `Instantiate many wasm contracts from the same code in one transaction.
+The json file holds a list of instances, e.g.
+[{"label":"vault-1","init_msg":{"count":1}},{"label":"vault-2","init_msg":{"count":2},"amount":"10uscrt"}]
+Labels must be unique, both within the batch and on chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseInstantiateBatchArgs(args, cliCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(flagAdmin, "", "Optional: Bech32 address of the admin of all the contracts")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseInstantiateBatchArgs(args []string, cliCtx client.Context, initFlags *flag.FlagSet) ([]sdk.Msg, error) {
+	codeID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	batchBz, err := os.ReadFile(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	instances, err := parseBatchInstances(batchBz)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := initFlags.GetString(flagAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("admin: %s", err)
+	}
+	if admin != "" {
+		if _, err = sdk.AccAddressFromBech32(admin); err != nil {
+			return nil, fmt.Errorf("admin address is not in bech32 format: %s", err)
+		}
+	}
+
+	// check all labels before encrypting anything, so a bad batch fails fast
+	for i, instance := range instances {
+		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, keeper.QueryContractAddress, instance.Label)
+		res, _, _ := cliCtx.Query(route)
+		if res != nil {
+			return nil, fmt.Errorf("instance %d: label %s already exists. You must choose a unique label for your contract instance", i, instance.Label)
+		}
+	}
+
+	codeHash, err := GetCodeHashByCodeId(cliCtx, args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	wasmCtx := wasmUtils.WASMContext{CLIContext: cliCtx}
+	msgs := make([]sdk.Msg, 0, len(instances))
+	for i, instance := range instances {
+		amount, err := sdk.ParseCoinsNormalized(instance.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("instance %d: %s", i, err)
+		}
+
+		initMsg := types.NewSecretMsg(codeHash, instance.InitMsg)
+		encryptedMsg, err := wasmCtx.Encrypt(initMsg.Serialize())
+		if err != nil {
+			return nil, err
+		}
+
+		msg := types.MsgInstantiateContract{
+			Sender:           cliCtx.GetFromAddress(),
+			CallbackCodeHash: "",
+			CodeID:           codeID,
+			Label:            instance.Label,
+			InitFunds:        amount,
+			InitMsg:          encryptedMsg,
+			Admin:            admin,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("instance %d: %s", i, err)
+		}
+		msgs = append(msgs, &msg)
+	}
+
+	return msgs, nil
+}
+
+// parseBatchInstances decodes the batch file of InstantiateContractBatchCmd and checks that every instance
+// has a label that is unique within the batch
+func parseBatchInstances(batchBz []byte) ([]batchInstance, error) {
+	var instances []batchInstance
+	if err := json.Unmarshal(batchBz, &instances); err != nil {
+		return nil, fmt.Errorf("batch file is not a valid list of instances: %s", err)
+	}
+	if len(instances) == 0 {
+		return nil, fmt.Errorf("batch file must contain at least one instance")
+	}
+
+	labels := make(map[string]bool, len(instances))
+	for i, instance := range instances {
+		if instance.Label == "" {
+			return nil, fmt.Errorf("instance %d: label is required on all contracts", i)
+		}
+		if labels[instance.Label] {
+			return nil, fmt.Errorf("instance %d: label %s appears more than once in the batch", i, instance.Label)
+		}
+		labels[instance.Label] = true
+	}
+	return instances, nil
+}
+
 // ExecuteContractCmd will instantiate a contract from previously uploaded code.
 func ExecuteContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -350,6 +618,8 @@ func ExecuteContractCmd() *cobra.Command {
 		"io-master-key.txt file, which you can get using the command `secretcli q register secret-network-params` ")
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagCorrelationID, "", "Optional: an id of your choice to record the result under, look it up with `secretcli q compute result-by-correlation-id`")
+	cmd.Flags().Bool(flagReadOnly, false, "Optional: execute without committing any state, fails if the contract writes to its state or sends messages")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -380,6 +650,11 @@ func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []b
 		return err
 	}
 
+	// not every command executing through here defines the flags, those don't set a correlation id
+	// and execute normally
+	correlationID, _ := cmd.Flags().GetString(flagCorrelationID)
+	readOnly, _ := cmd.Flags().GetBool(flagReadOnly)
+
 	// build and sign the transaction, then broadcast to Tendermint
 	msgExec := types.MsgExecuteContract{
 		Sender:           cliCtx.GetFromAddress(),
@@ -387,6 +662,8 @@ func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []b
 		CallbackCodeHash: "",
 		SentFunds:        coins,
 		Msg:              encryptedMsg,
+		CorrelationID:    correlationID,
+		ReadOnly:         readOnly,
 	}
 	return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msgExec)
 }
@@ -547,3 +824,125 @@ func ClearContractAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// PauseContractCmd rejects all executions of a contract until it is resumed
+func PauseContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [contract_addr_bech32]",
+		Short: "Pauses a contract so it can no longer be executed, only the admin can do this",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPauseContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ResumeContractCmd allows executions of a paused contract again
+func ResumeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume [contract_addr_bech32]",
+		Short: "Resumes a paused contract, only the admin can do this",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgResumeContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ProposeContractMigrationCmd announces a migration that can only be executed after a challenge window
+func ProposeContractMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-migration [contract_addr_bech32] [new_code_id_int64] [execute_after_height]",
+		Short: "Announces a migration of a contract to a new code, which can be executed with migrate after the given height. Only the admin can do this",
+		Long: "Announces a migration of a contract to a new code, which can be executed with migrate after the given height. Only the admin can do this. " +
+			"The height has to be at least " + strconv.Itoa(types.MinMigrationDelay) + " blocks after the current one. " +
+			"After the first proposal the contract can only be migrated to the code of a pending migration whose challenge window is over.",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+			executeAfterHeight, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "execute after height")
+			}
+
+			msg := types.MsgProposeContractMigration{
+				Sender:             clientCtx.GetFromAddress().String(),
+				Contract:           args[0],
+				CodeID:             codeID,
+				ExecuteAfterHeight: executeAfterHeight,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelContractMigrationCmd cancels the pending migration of a contract
+func CancelContractMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-migration [contract_addr_bech32]",
+		Short: "Cancels the pending migration of a contract, only the admin can do this",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelContractMigration{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBatchInstances(t *testing.T) {
+	specs := map[string]struct {
+		batch        string
+		expInstances []batchInstance
+		expErr       string
+	}{
+		"well formed": {
+			batch: `[{"label":"vault-1","init_msg":{"count":1}},{"label":"vault-2","init_msg":{"count":2},"amount":"10uscrt"}]`,
+			expInstances: []batchInstance{
+				{Label: "vault-1", InitMsg: json.RawMessage(`{"count":1}`)},
+				{Label: "vault-2", InitMsg: json.RawMessage(`{"count":2}`), Amount: "10uscrt"},
+			},
+		},
+		"single instance": {
+			batch:        `[{"label":"vault-1","init_msg":{}}]`,
+			expInstances: []batchInstance{{Label: "vault-1", InitMsg: json.RawMessage(`{}`)}},
+		},
+		"not json": {
+			batch:  `vault-1`,
+			expErr: "batch file is not a valid list of instances",
+		},
+		"object instead of list": {
+			batch:  `{"label":"vault-1","init_msg":{}}`,
+			expErr: "batch file is not a valid list of instances",
+		},
+		"truncated": {
+			batch:  `[{"label":"vault-1","init_msg":{}}`,
+			expErr: "batch file is not a valid list of instances",
+		},
+		"missing label": {
+			batch:  `[{"label":"vault-1","init_msg":{}},{"init_msg":{}}]`,
+			expErr: "instance 1: label is required on all contracts",
+		},
+		"duplicate label": {
+			batch:  `[{"label":"vault-1","init_msg":{}},{"label":"vault-1","init_msg":{}}]`,
+			expErr: "instance 1: label vault-1 appears more than once in the batch",
+		},
+		"empty list": {
+			batch:  `[]`,
+			expErr: "batch file must contain at least one instance",
+		},
+		"null": {
+			batch:  `null`,
+			expErr: "batch file must contain at least one instance",
+		},
+		"empty file": {
+			batch:  ``,
+			expErr: "batch file is not a valid list of instances",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			instances, err := parseBatchInstances([]byte(spec.batch))
+			if spec.expErr != "" {
+				require.ErrorContains(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expInstances, instances)
+		})
+	}
+}
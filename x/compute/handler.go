@@ -7,7 +7,6 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
 
@@ -33,6 +32,16 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleUpdateAdmin(ctx, k, msg)
 		case *MsgClearAdmin:
 			return handleClearAdmin(ctx, k, msg)
+		case *MsgPauseContract:
+			return handleSetContractPaused(ctx, k, msg.Sender, msg.Contract, true)
+		case *MsgResumeContract:
+			return handleSetContractPaused(ctx, k, msg.Sender, msg.Contract, false)
+		case *MsgTerminateContract:
+			return handleTerminateContract(ctx, k, msg)
+		case *MsgProposeContractMigration:
+			return handleProposeContractMigration(ctx, k, msg)
+		case *MsgCancelContractMigration:
+			return handleCancelContractMigration(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -59,7 +68,10 @@ func handleStoreCode(ctx sdk.Context, k Keeper, msg *MsgStoreCode) (*sdk.Result,
 		return nil, err
 	}
 
-	codeID, err := k.Create(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder)
+	codeID, err := k.CreateWithOptions(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder, CreateOptions{
+		InstantiatePermission: msg.InstantiatePermission,
+		SourceChecksum:        msg.SourceChecksum,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +100,16 @@ func handleInstantiate(ctx sdk.Context, k Keeper, msg *MsgInstantiateContract) (
 		}
 	}
 
-	contractAddr, data, err := k.Instantiate(ctx, msg.CodeID, msg.Sender, adminAddr, msg.InitMsg, msg.Label, msg.InitFunds, msg.CallbackSig)
+	var contractAddr sdk.AccAddress
+	var data []byte
+	err = k.MeterBlockComputeGas(ctx, func() (err error) {
+		contractAddr, data, err = k.InstantiateWithOptions(ctx, msg.CodeID, msg.Sender, adminAddr, msg.InitMsg, msg.Label, msg.InitFunds, msg.CallbackSig, InstantiateOptions{
+			Salt:                msg.Salt,
+			ReentrancyProtected: msg.ReentrancyProtected,
+			SendWhitelist:       msg.SendWhitelist,
+		})
+		return err
+	})
 	if err != nil {
 		result := sdk.Result{}
 		result.Data = data
@@ -124,15 +145,29 @@ func handleInstantiate(ctx sdk.Context, k Keeper, msg *MsgInstantiateContract) (
 }
 
 func handleExecute(ctx sdk.Context, k Keeper, msg *MsgExecuteContract) (*sdk.Result, error) {
-	res, err := k.Execute(
-		ctx,
-		msg.Contract,
-		msg.Sender,
-		msg.Msg,
-		msg.SentFunds,
-		msg.CallbackSig,
-		wasmtypes.HandleTypeExecute,
-	)
+	var res *sdk.Result
+	err := k.MeterBlockComputeGas(ctx, func() (err error) {
+		if msg.ReadOnly {
+			res, err = k.ExecuteReadOnly(
+				ctx,
+				msg.Contract,
+				msg.Sender,
+				msg.Msg,
+				msg.CallbackSig,
+			)
+		} else {
+			res, err = k.ExecuteCorrelated(
+				ctx,
+				msg.Contract,
+				msg.Sender,
+				msg.Msg,
+				msg.SentFunds,
+				msg.CallbackSig,
+				msg.CorrelationID,
+			)
+		}
+		return err
+	})
 	if err != nil {
 		return res, err
 	}
@@ -227,3 +262,94 @@ func handleClearAdmin(ctx sdk.Context, k Keeper, msg *MsgClearAdmin) (*sdk.Resul
 
 	return &sdk.Result{Events: events}, nil
 }
+
+func handleSetContractPaused(ctx sdk.Context, k Keeper, sender string, contract string, paused bool) (*sdk.Result, error) {
+	err := k.SetContractPaused(
+		ctx,
+		sdk.MustAccAddressFromBech32(contract),
+		sdk.MustAccAddressFromBech32(sender),
+		paused,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := filteredMessageEvents(ctx.EventManager())
+	custom := sdk.Events{sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contract),
+	)}
+	events = append(events, custom.ToABCIEvents()...)
+
+	return &sdk.Result{Events: events}, nil
+}
+
+func handleTerminateContract(ctx sdk.Context, k Keeper, msg *MsgTerminateContract) (*sdk.Result, error) {
+	err := k.TerminateContract(
+		ctx,
+		sdk.MustAccAddressFromBech32(msg.Contract),
+		sdk.MustAccAddressFromBech32(msg.Recipient),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := filteredMessageEvents(ctx.EventManager())
+	custom := sdk.Events{sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Contract),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, msg.Contract),
+	)}
+	events = append(events, custom.ToABCIEvents()...)
+
+	return &sdk.Result{Events: events}, nil
+}
+
+func handleProposeContractMigration(ctx sdk.Context, k Keeper, msg *MsgProposeContractMigration) (*sdk.Result, error) {
+	err := k.ProposeContractMigration(
+		ctx,
+		sdk.MustAccAddressFromBech32(msg.Contract),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.CodeID,
+		msg.ExecuteAfterHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := filteredMessageEvents(ctx.EventManager())
+	custom := sdk.Events{sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, msg.Contract),
+	)}
+	events = append(events, custom.ToABCIEvents()...)
+
+	return &sdk.Result{Events: events}, nil
+}
+
+func handleCancelContractMigration(ctx sdk.Context, k Keeper, msg *MsgCancelContractMigration) (*sdk.Result, error) {
+	err := k.CancelContractMigration(
+		ctx,
+		sdk.MustAccAddressFromBech32(msg.Contract),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := filteredMessageEvents(ctx.EventManager())
+	custom := sdk.Events{sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, msg.Contract),
+	)}
+	events = append(events, custom.ToABCIEvents()...)
+
+	return &sdk.Result{Events: events}, nil
+}
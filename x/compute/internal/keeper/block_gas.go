@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// MeterBlockComputeGas runs fn, an instantiate or execute message, against the MaxBlockComputeGas
+// param. It fails with ErrBlockComputeGasExceeded without running fn once the messages of the
+// block used up the limit, otherwise it adds the gas fn used to the block's total, even when fn
+// fails, so failing txs can't get past the limit. The last message that fits may take the total
+// past the limit, since its gas isn't known before it ran.
+//
+// The total is kept in memory rather than in the store, where a failed tx's writes are discarded.
+// Every validator runs the txs of a block in the same order and ResetBlockComputeGas clears the
+// total at the end of every block, so it's the same on every node. The limit isn't enforced in
+// CheckTx and simulations: they run against the last committed block, so a full block would
+// reject every tx and break gas estimation until the next one.
+func (k Keeper) MeterBlockComputeGas(ctx sdk.Context, fn func() error) error {
+	if ctx.IsCheckTx() {
+		return fn()
+	}
+
+	var limit uint64
+	k.getParamUnmetered(ctx, types.ParamStoreKeyMaxBlockComputeGas, &limit)
+	if limit == 0 {
+		return fn()
+	}
+
+	used := k.blockComputeGasUsed()
+	if used >= limit {
+		return sdkerrors.Wrapf(types.ErrBlockComputeGasExceeded, "contract messages used %d of %d gas in block %d", used, limit, ctx.BlockHeight())
+	}
+
+	start := ctx.GasMeter().GasConsumed()
+	defer func() {
+		*k.blockComputeGas += ctx.GasMeter().GasConsumed() - start
+	}()
+	return fn()
+}
+
+// blockComputeGasUsed returns the gas the instantiate and execute messages of the current block used so far
+func (k Keeper) blockComputeGasUsed() uint64 {
+	return *k.blockComputeGas
+}
+
+// ResetBlockComputeGas forgets the gas the contract messages of the block used, called at the end of every block
+func (k Keeper) ResetBlockComputeGas() {
+	*k.blockComputeGas = 0
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// contractStorage is the store handed to the enclave when a contract may write to its state.
+// It keeps track of the number of bytes (keys and values) the contract holds and refuses writes
+// that would take it past the MaxContractStorageBytes limit.
+//
+// The size of an entry being overwritten or deleted is read from a store that isn't gas metered,
+// so the accounting doesn't change the gas cost of contract writes.
+//
+// The bytes of the entries the contract deletes are summed up in freed, for the storage deletion
+// refund of refundFreedStorageGas.
+type contractStorage struct {
+	sdk.KVStore
+	unmetered sdk.KVStore
+	limit     uint64
+	original  uint64
+	used      uint64
+	exceeded  bool
+	freed     uint64
+	startGas  uint64
+}
+
+func (k Keeper) newContractStorage(ctx sdk.Context, contractAddress sdk.AccAddress, store sdk.KVStore) *contractStorage {
+	used := k.GetContractStorageUsage(ctx, contractAddress)
+	limit := types.DefaultParams().MaxContractStorageBytes
+	k.getParamUnmetered(ctx, types.ParamStoreKeyMaxContractStorageBytes, &limit)
+	return &contractStorage{
+		KVStore:   store,
+		unmetered: prefix.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), types.GetContractStorePrefixKey(contractAddress)),
+		limit:     limit,
+		original:  used,
+		used:      used,
+		startGas:  ctx.GasMeter().GasConsumed(),
+	}
+}
+
+// Set implements KVStore. Once the contract ran out of storage no further writes are applied;
+// the whole call is rejected by commitContractStorage afterwards.
+func (s *contractStorage) Set(key, value []byte) {
+	if s.exceeded {
+		return
+	}
+
+	used := s.used + entrySize(key, value)
+	if previous := s.unmetered.Get(key); previous != nil {
+		used = subtractSize(used, entrySize(key, previous))
+	}
+
+	// shrinking is always allowed, so contracts above a lowered limit can still clean up
+	if s.limit > 0 && used > s.limit && used > s.used {
+		s.exceeded = true
+		return
+	}
+
+	s.KVStore.Set(key, value)
+	s.used = used
+}
+
+// Delete implements KVStore.
+func (s *contractStorage) Delete(key []byte) {
+	if previous := s.unmetered.Get(key); previous != nil {
+		s.used = subtractSize(s.used, entrySize(key, previous))
+		s.freed += entrySize(key, previous)
+	}
+	s.KVStore.Delete(key)
+}
+
+// commitContractStorage persists the storage usage of a contract after a call into the enclave,
+// or returns ErrOutOfStorage if the contract tried to write past its limit.
+func (k Keeper) commitContractStorage(ctx sdk.Context, contractAddress sdk.AccAddress, storage *contractStorage) error {
+	if storage.exceeded {
+		return sdkerrors.Wrapf(types.ErrOutOfStorage, "contract %s exceeded the storage limit of %d bytes", contractAddress, storage.limit)
+	}
+	if storage.used != storage.original {
+		k.setContractStorageUsage(ctx, contractAddress, storage.used)
+	}
+	return nil
+}
+
+// refundFreedStorageGas credits the tx with StorageDeletionRefundPercent of the per-byte write gas
+// of the entries a successful call deleted. The refund is capped at MaxStorageRefundPercent of
+// the gas the call used, so a contract can't get back more than a fraction of what it paid by
+// writing and deleting the same entries over and over.
+func (k Keeper) refundFreedStorageGas(ctx sdk.Context, storage *contractStorage) {
+	if storage.freed == 0 {
+		return
+	}
+	percent := types.DefaultParams().StorageDeletionRefundPercent
+	k.getParamUnmetered(ctx, types.ParamStoreKeyStorageDeletionRefundPercent, &percent)
+	if percent == 0 {
+		return
+	}
+
+	refund := storage.freed * storetypes.KVGasConfig().WriteCostPerByte * percent / 100
+	used := ctx.GasMeter().GasConsumed() - storage.startGas
+	if limit := used * types.MaxStorageRefundPercent / 100; refund > limit {
+		refund = limit
+	}
+	ctx.GasMeter().RefundGas(refund, "contract storage deletion refund")
+}
+
+// GetContractStorageUsage returns the number of bytes (keys and values) a contract holds in its state
+func (k Keeper) GetContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress) uint64 {
+	bz := ctx.MultiStore().GetKVStore(k.storeKey).Get(types.GetContractStorageUsageKey(contractAddress))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress, used uint64) {
+	ctx.MultiStore().GetKVStore(k.storeKey).Set(types.GetContractStorageUsageKey(contractAddress), sdk.Uint64ToBigEndian(used))
+}
+
+// computeContractStorageUsage sums up the size of every entry in a contract's state
+func (k Keeper) computeContractStorageUsage(ctx sdk.Context, contractAddress sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), types.GetContractStorePrefixKey(contractAddress))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var used uint64
+	for ; iter.Valid(); iter.Next() {
+		used += entrySize(iter.Key(), iter.Value())
+	}
+	return used
+}
+
+func entrySize(key, value []byte) uint64 {
+	return uint64(len(key) + len(value))
+}
+
+func subtractSize(used, size uint64) uint64 {
+	if size > used {
+		return 0
+	}
+	return used - size
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// ExecuteCorrelated executes the contract instance like Execute and records the result under
+// correlationID, so the caller can look it up without knowing the tx hash. Correlation ids are
+// scoped to the caller and can only be used once while the result is kept, a second execution
+// with the same id fails with ErrDuplicate. Results are pruned ExecutionResultRetention blocks
+// after they were recorded. An empty correlationID records nothing.
+func (k Keeper) ExecuteCorrelated(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, callbackSig []byte, correlationID string) (*sdk.Result, error) {
+	if correlationID == "" {
+		return k.Execute(ctx, contractAddress, caller, msg, coins, callbackSig, wasmTypes.HandleTypeExecute)
+	}
+
+	key := types.GetExecutionResultByCorrelationIDKey(caller, correlationID)
+	if ctx.KVStore(k.storeKey).Has(key) {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "correlation id %q was already used by %s", correlationID, caller)
+	}
+
+	res, err := k.Execute(ctx, contractAddress, caller, msg, coins, callbackSig, wasmTypes.HandleTypeExecute)
+	if err != nil {
+		return res, err
+	}
+
+	k.setExecutionResult(ctx, caller, correlationID, types.ContractExecutionResult{
+		ContractAddress: contractAddress.String(),
+		Data:            res.Data,
+		Height:          ctx.BlockHeight(),
+	})
+
+	return res, nil
+}
+
+func (k Keeper) setExecutionResult(ctx sdk.Context, sender sdk.AccAddress, correlationID string, result types.ContractExecutionResult) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetExecutionResultByCorrelationIDKey(sender, correlationID), k.cdc.MustMarshal(&result))
+	store.Set(types.GetExecutionResultByHeightKey(result.Height, sender, correlationID), []byte{})
+}
+
+// GetExecutionResultByCorrelationID returns the result of the execution sender tagged with
+// correlationID, or nil if there is none
+func (k Keeper) GetExecutionResultByCorrelationID(ctx sdk.Context, sender sdk.AccAddress, correlationID string) *types.ContractExecutionResult {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetExecutionResultByCorrelationIDKey(sender, correlationID))
+	if bz == nil {
+		return nil
+	}
+	var result types.ContractExecutionResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return &result
+}
+
+// IterateExecutionResults calls cb for every recorded execution result, ordered by sender and correlation id
+func (k Keeper) IterateExecutionResults(ctx sdk.Context, cb func(types.CorrelatedExecutionResult) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutionResultByCorrelationIDPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		senderLen := int(key[0])
+		correlated := types.CorrelatedExecutionResult{
+			Sender:        sdk.AccAddress(key[1 : 1+senderLen]),
+			CorrelationID: string(key[1+senderLen:]),
+		}
+		k.cdc.MustUnmarshal(iter.Value(), &correlated.Result)
+		if cb(correlated) {
+			break
+		}
+	}
+}
+
+// PruneExecutionResults removes the execution results that were recorded ExecutionResultRetention
+// or more blocks ago, called at the end of every block
+func (k Keeper) PruneExecutionResults(ctx sdk.Context) {
+	cutoff := ctx.BlockHeight() - types.ExecutionResultRetention
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.ExecutionResultByHeightPrefix, types.GetExecutionResultByHeightPrefix(cutoff+1))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		// the rest of the key is the one the result is recorded under
+		store.Delete(append(append([]byte{}, types.ExecutionResultByCorrelationIDPrefix...), key[len(types.ExecutionResultByHeightPrefix)+8:]...))
+	}
+}
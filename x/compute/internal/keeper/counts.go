@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// GetCodesCount returns the number of codes stored on chain
+func (k Keeper) GetCodesCount(ctx sdk.Context) uint64 {
+	return k.getCount(ctx, types.KeyCodesCount)
+}
+
+// GetContractsCount returns the number of contracts instantiated on chain
+func (k Keeper) GetContractsCount(ctx sdk.Context) uint64 {
+	return k.getCount(ctx, types.KeyContractsCount)
+}
+
+// getCount reads one of the counters kept next to the id sequences. Unlike the sequences the
+// counters start from zero.
+func (k Keeper) getCount(ctx sdk.Context, countKey []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(countKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setCount(ctx sdk.Context, countKey []byte, count uint64) {
+	ctx.KVStore(k.storeKey).Set(countKey, sdk.Uint64ToBigEndian(count))
+}
+
+func (k Keeper) incrementCount(ctx sdk.Context, countKey []byte) {
+	k.setCount(ctx, countKey, k.getCount(ctx, countKey)+1)
+}
+
+// importCount sets a counter to the number of entries imported from genesis. A counter that
+// came with the genesis sequences has to match that number.
+func (k Keeper) importCount(ctx sdk.Context, countKey []byte, imported uint64) error {
+	if ctx.KVStore(k.storeKey).Has(countKey) {
+		if count := k.getCount(ctx, countKey); count != imported {
+			return sdkerrors.Wrapf(types.ErrInvalid, "seq %s is %d but %d entries were imported", string(countKey), count, imported)
+		}
+		return nil
+	}
+	k.setCount(ctx, countKey, imported)
+	return nil
+}
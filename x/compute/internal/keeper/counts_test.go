@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestCodesAndContractsCount(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	require.Equal(t, uint64(1), keeper.GetCodesCount(ctx))
+	require.Equal(t, uint64(0), keeper.GetContractsCount(ctx))
+
+	for i := uint64(1); i <= 2; i++ {
+		_, _, _, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+		require.Empty(t, initErr)
+		require.Equal(t, i, keeper.GetContractsCount(ctx))
+	}
+
+	_, _ = uploadCode(ctx, t, keeper, TestContractPaths[v010Contract], walletA)
+	require.Equal(t, uint64(2), keeper.GetCodesCount(ctx))
+	require.Equal(t, uint64(2), keeper.GetContractsCount(ctx))
+
+	genState := ExportGenesis(ctx, keeper)
+	require.Contains(t, genState.Sequences, types.Sequence{IDKey: types.KeyCodesCount, Value: 2})
+	require.Contains(t, genState.Sequences, types.Sequence{IDKey: types.KeyContractsCount, Value: 2})
+}
+
+func TestImportCount(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	// exports that predate the counters set them from the imported entries
+	require.NoError(t, keeper.importCount(ctx, types.KeyCodesCount, 3))
+	require.Equal(t, uint64(3), keeper.GetCodesCount(ctx))
+
+	require.NoError(t, keeper.importAutoIncrementID(ctx, types.KeyContractsCount, 2))
+	require.NoError(t, keeper.importCount(ctx, types.KeyContractsCount, 2))
+	require.Equal(t, uint64(2), keeper.GetContractsCount(ctx))
+
+	err := keeper.importCount(ctx, types.KeyContractsCount, 1)
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
@@ -6,12 +6,17 @@ import (
 	"os"
 	"testing"
 
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -105,3 +110,53 @@ func TestDistributionRewards(t *testing.T) {
 	// returns the rewards
 	require.Equal(t, uint64(0x59), binary.BigEndian.Uint64(res))
 }
+
+// TestContractFundCommunityPool tests that a contract can donate to the community pool with a Stargate
+// MsgFundCommunityPool, and that it can't donate more than its balance
+func TestContractFundCommunityPool(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	var transferPortSource types.ICS20TransferPortSource
+	transferPortSource = MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}}
+	encoders := DefaultEncoders(transferPortSource, encodingConfig.Marshaler)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
+	accKeeper, keeper, distKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.DistKeeper
+
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	distrtypes.RegisterMsgServer(msgRouter, distrkeeper.NewMsgServerImpl(distKeeper))
+	handler := NewSDKMessageHandler(msgRouter, nil, encoders, keeper, keeper.bankKeeper)
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))
+	contractAddr, _ := CreateFakeFundedAccount(ctx, accKeeper, keeper.bankKeeper, balance)
+
+	fundCommunityPool := func(amount sdk.Coins) ([]sdk.Event, error) {
+		bz, err := encodingConfig.Marshaler.Marshal(distrtypes.NewMsgFundCommunityPool(amount, contractAddr))
+		require.NoError(t, err)
+
+		events, _, err := handler.DispatchMsg(ctx, contractAddr, "", v1wasmTypes.CosmosMsg{
+			Stargate: &v1wasmTypes.StargateMsg{
+				TypeURL: "/cosmos.distribution.v1beta1.MsgFundCommunityPool",
+				Value:   bz,
+			},
+		})
+		return events, err
+	}
+
+	_, err := fundCommunityPool(balance.Add(sdk.NewInt64Coin("stake", 1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.True(t, distKeeper.GetFeePoolCommunityCoins(ctx).IsZero())
+	require.Equal(t, balance, keeper.bankKeeper.GetAllBalances(ctx, contractAddr))
+
+	donation := sdk.NewCoins(sdk.NewInt64Coin("stake", 400))
+	events, err := fundCommunityPool(donation)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(donation...), distKeeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, balance.Sub(donation), keeper.bankKeeper.GetAllBalances(ctx, contractAddr))
+	require.Contains(t, events, sdk.NewEvent(
+		types.EventTypeFundCommunityPool,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, donation.String()),
+	))
+}
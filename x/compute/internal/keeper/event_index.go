@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// indexContractEvents indexes the attributes of the events a contract emitted that carry the
+// AttributeIndexedPrefix, so they can be found with Query/ContractEventsByAttribute. Writing the
+// index is charged like any other write of the contract. Attributes with a key or value that is
+// too long aren't indexed. The index holds the MaxIndexedEventAttributes most recent attributes
+// of a contract, indexing another one drops the oldest.
+func (k Keeper) indexContractEvents(ctx sdk.Context, contractAddr sdk.AccAddress, events sdk.Events) {
+	store := ctx.KVStore(k.storeKey)
+	countKey := types.GetIndexedEventAttributeCountKey(contractAddr)
+
+	var count uint64
+	if bz := store.Get(countKey); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	seq := count
+
+	for _, event := range events {
+		for _, attr := range event.Attributes {
+			key := string(attr.Key)
+			if !strings.HasPrefix(key, types.AttributeIndexedPrefix) {
+				continue
+			}
+			key = strings.TrimPrefix(key, types.AttributeIndexedPrefix)
+			if key == "" || len(key) > types.MaxIndexedEventAttributeKeySize || len(attr.Value) > types.MaxIndexedEventAttributeValueSize {
+				continue
+			}
+
+			k.setIndexedEventAttribute(ctx, contractAddr, seq, types.IndexedEventAttribute{
+				EventType: event.Type,
+				Key:       key,
+				Value:     string(attr.Value),
+				Height:    ctx.BlockHeight(),
+			})
+			if seq >= types.MaxIndexedEventAttributes {
+				k.deleteIndexedEventAttribute(ctx, contractAddr, seq-types.MaxIndexedEventAttributes)
+			}
+			seq++
+		}
+	}
+
+	if seq != count {
+		store.Set(countKey, sdk.Uint64ToBigEndian(seq))
+	}
+}
+
+func (k Keeper) setIndexedEventAttribute(ctx sdk.Context, contractAddr sdk.AccAddress, seq uint64, attr types.IndexedEventAttribute) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetIndexedEventAttributeKey(contractAddr, attr.Key, seq), k.cdc.MustMarshal(&attr))
+	store.Set(types.GetIndexedEventAttributeBySeqKey(contractAddr, seq), []byte(attr.Key))
+}
+
+func (k Keeper) deleteIndexedEventAttribute(ctx sdk.Context, contractAddr sdk.AccAddress, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bySeqKey := types.GetIndexedEventAttributeBySeqKey(contractAddr, seq)
+	key := store.Get(bySeqKey)
+	if key == nil {
+		return
+	}
+	store.Delete(types.GetIndexedEventAttributeKey(contractAddr, string(key), seq))
+	store.Delete(bySeqKey)
+}
+
+// IterateIndexedEventAttributes calls cb for every indexed event attribute of a contract, in the
+// order they were indexed
+func (k Keeper) IterateIndexedEventAttributes(ctx sdk.Context, contractAddr sdk.AccAddress, cb func(types.IndexedEventAttribute) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(store, types.GetIndexedEventAttributeBySeqPrefix(contractAddr)).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		seq := sdk.BigEndianToUint64(iter.Key())
+		var attr types.IndexedEventAttribute
+		k.cdc.MustUnmarshal(store.Get(types.GetIndexedEventAttributeKey(contractAddr, string(iter.Value()), seq)), &attr)
+		if cb(attr) {
+			break
+		}
+	}
+}
+
+// importIndexedEventAttributes indexes the event attributes of a contract from genesis, which
+// are in the order they were indexed
+func (k Keeper) importIndexedEventAttributes(ctx sdk.Context, contractAddr sdk.AccAddress, attrs []types.IndexedEventAttribute) {
+	if len(attrs) == 0 {
+		return
+	}
+	for i, attr := range attrs {
+		k.setIndexedEventAttribute(ctx, contractAddr, uint64(i), attr)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetIndexedEventAttributeCountKey(contractAddr), sdk.Uint64ToBigEndian(uint64(len(attrs))))
+}
+
+// deleteIndexedEventAttributes removes the whole event attribute index of a contract. This
+// isn't charged, the index was charged when it was written and holds at most
+// MaxIndexedEventAttributes attributes.
+func (k Keeper) deleteIndexedEventAttributes(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	store := ctx.KVStore(k.storeKey)
+
+	var seqs []uint64
+	iter := prefix.NewStore(store, types.GetIndexedEventAttributeBySeqPrefix(contractAddr)).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		seqs = append(seqs, sdk.BigEndianToUint64(iter.Key()))
+	}
+	iter.Close()
+
+	for _, seq := range seqs {
+		k.deleteIndexedEventAttribute(ctx, contractAddr, seq)
+	}
+	store.Delete(types.GetIndexedEventAttributeCountKey(contractAddr))
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestContractEventsByAttribute(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, contractAddress := keyPubAddr()
+	_, _, otherContract := keyPubAddr()
+
+	keeper.indexContractEvents(ctx, contractAddress, sdk.Events{
+		sdk.NewEvent(types.CustomEventType,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+			sdk.NewAttribute("indexed:order", "1"),
+			// not marked, or encrypted
+			sdk.NewAttribute("order", "2"),
+			sdk.NewAttribute("b3JkZXI=", "Mg=="),
+		),
+		sdk.NewEvent(types.CustomContractEventPrefix+"filled",
+			sdk.NewAttribute("indexed:order", "3"),
+			sdk.NewAttribute("indexed:buyer", "alice"),
+		),
+	})
+	keeper.indexContractEvents(ctx.WithBlockHeight(ctx.BlockHeight()+1), contractAddress, sdk.Events{
+		sdk.NewEvent(types.CustomEventType, sdk.NewAttribute("indexed:order", "1")),
+	})
+	keeper.indexContractEvents(ctx, otherContract, sdk.Events{
+		sdk.NewEvent(types.CustomEventType, sdk.NewAttribute("indexed:order", "4")),
+	})
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	rsp, err := grpcQuerier.ContractEventsByAttribute(goCtx, &types.QueryContractEventsByAttributeRequest{
+		ContractAddress: contractAddress.String(),
+		Key:             "order",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.IndexedEventAttribute{
+		{EventType: types.CustomEventType, Key: "order", Value: "1", Height: ctx.BlockHeight()},
+		{EventType: types.CustomContractEventPrefix + "filled", Key: "order", Value: "3", Height: ctx.BlockHeight()},
+		{EventType: types.CustomEventType, Key: "order", Value: "1", Height: ctx.BlockHeight() + 1},
+	}, rsp.Attributes)
+
+	// filtered by value and paginated
+	rsp, err = grpcQuerier.ContractEventsByAttribute(goCtx, &types.QueryContractEventsByAttributeRequest{
+		ContractAddress: contractAddress.String(),
+		Key:             "order",
+		Value:           "1",
+		Pagination:      &sdkquery.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, rsp.Attributes, 1)
+	require.Equal(t, ctx.BlockHeight(), rsp.Attributes[0].Height)
+	require.EqualValues(t, 2, rsp.Pagination.Total)
+
+	rsp, err = grpcQuerier.ContractEventsByAttribute(goCtx, &types.QueryContractEventsByAttributeRequest{
+		ContractAddress: contractAddress.String(),
+		Key:             "buyer",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.IndexedEventAttribute{
+		{EventType: types.CustomContractEventPrefix + "filled", Key: "buyer", Value: "alice", Height: ctx.BlockHeight()},
+	}, rsp.Attributes)
+
+	_, err = grpcQuerier.ContractEventsByAttribute(goCtx, &types.QueryContractEventsByAttributeRequest{
+		ContractAddress: contractAddress.String(),
+	})
+	require.ErrorIs(t, err, types.ErrEmpty)
+}
+
+func TestIndexContractEventsLimit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, contractAddress := keyPubAddr()
+	query := func() []types.IndexedEventAttribute {
+		rsp, err := NewGrpcQuerier(keeper).ContractEventsByAttribute(sdk.WrapSDKContext(ctx), &types.QueryContractEventsByAttributeRequest{
+			ContractAddress: contractAddress.String(),
+			Key:             "order",
+		})
+		require.NoError(t, err)
+		return rsp.Attributes
+	}
+
+	keeper.indexContractEvents(ctx, contractAddress, sdk.Events{
+		sdk.NewEvent(types.CustomEventType,
+			sdk.NewAttribute("indexed:order", "0"),
+			// too long to be indexed
+			sdk.NewAttribute("indexed:order", strings.Repeat("x", types.MaxIndexedEventAttributeValueSize+1)),
+		),
+	})
+	require.Equal(t, []types.IndexedEventAttribute{
+		{EventType: types.CustomEventType, Key: "order", Value: "0", Height: ctx.BlockHeight()},
+	}, query())
+
+	// the index is full, further attributes drop the oldest ones
+	ctx.KVStore(keeper.storeKey).Set(types.GetIndexedEventAttributeCountKey(contractAddress), sdk.Uint64ToBigEndian(types.MaxIndexedEventAttributes-1))
+	keeper.indexContractEvents(ctx, contractAddress, sdk.Events{
+		sdk.NewEvent(types.CustomEventType,
+			sdk.NewAttribute("indexed:order", "1"),
+			sdk.NewAttribute("indexed:order", "2"),
+		),
+	})
+	require.Equal(t, []types.IndexedEventAttribute{
+		{EventType: types.CustomEventType, Key: "order", Value: "1", Height: ctx.BlockHeight()},
+		{EventType: types.CustomEventType, Key: "order", Value: "2", Height: ctx.BlockHeight()},
+	}, query())
+}
+
+func TestTerminateContractDeletesEventIndex(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, contractAddress := keyPubAddr()
+	_, _, recipient := keyPubAddr()
+	contractInfo := types.ContractInfoFixture()
+	keeper.setContractInfo(ctx, contractAddress, &contractInfo)
+
+	keeper.indexContractEvents(ctx, contractAddress, sdk.Events{
+		sdk.NewEvent(types.CustomEventType, sdk.NewAttribute("indexed:order", "1")),
+	})
+	require.NoError(t, keeper.TerminateContract(ctx, contractAddress, recipient))
+
+	var attrs []types.IndexedEventAttribute
+	keeper.IterateIndexedEventAttributes(ctx, contractAddress, func(attr types.IndexedEventAttribute) bool {
+		attrs = append(attrs, attr)
+		return false
+	})
+	require.Empty(t, attrs)
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetIndexedEventAttributeKey(contractAddress, "order", 0)))
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetIndexedEventAttributeCountKey(contractAddress)))
+}
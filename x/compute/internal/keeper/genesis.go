@@ -26,10 +26,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 	var maxContractID int
 	for i := range data.Contracts {
 		contract := data.Contracts[i] // This is to prevent golint from complaining about referencing a for variable address
-		err := keeper.importContract(ctx, contract.ContractAddress, contract.ContractCustomInfo, &contract.ContractInfo, contract.ContractState)
+		err := keeper.importContract(ctx, contract.ContractAddress, contract.ContractCustomInfo, &contract.ContractInfo, contract.ContractState, contract.ContractCodeHistory)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		keeper.importIndexedEventAttributes(ctx, contract.ContractAddress, contract.IndexedEventAttributes)
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
@@ -40,6 +41,32 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 		}
 	}
 
+	for i, contractSalt := range data.ContractSalts {
+		if !keeper.containsContractInfo(ctx, contractSalt.ContractAddress) {
+			return sdkerrors.Wrapf(types.ErrNotFound, "contract salt %d: contract %s", i, contractSalt.ContractAddress)
+		}
+		keeper.setContractSalt(ctx, contractSalt)
+	}
+
+	for i, pending := range data.PendingMigrations {
+		contractAddress := sdk.MustAccAddressFromBech32(pending.ContractAddress)
+		if !keeper.containsContractInfo(ctx, contractAddress) {
+			return sdkerrors.Wrapf(types.ErrNotFound, "pending migration %d: contract %s", i, pending.ContractAddress)
+		}
+		keeper.setPendingMigration(ctx, contractAddress, pending)
+	}
+
+	for _, correlated := range data.CorrelatedExecutionResults {
+		keeper.setExecutionResult(ctx, correlated.Sender, correlated.CorrelationID, correlated.Result)
+	}
+
+	if err := keeper.importCount(ctx, types.KeyCodesCount, uint64(len(data.Codes))); err != nil {
+		return err
+	}
+	if err := keeper.importCount(ctx, types.KeyContractsCount, uint64(len(data.Contracts))); err != nil {
+		return err
+	}
+
 	// sanity check seq values
 	if keeper.peekAutoIncrementID(ctx, types.KeyLastCodeID) <= maxCodeID {
 		return sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastCodeID), maxCodeID)
@@ -47,7 +74,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 	if keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) <= uint64(maxContractID) {
 		return sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastInstanceID), maxContractID)
 	}
-	// keeper.setParams(ctx, data.Params)
+	keeper.setParams(ctx, data.Params)
 
 	return nil
 }
@@ -56,7 +83,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 	var genState types.GenesisState
 
-	// genState.Params = keeper.GetParams(ctx)
+	genState.Params = keeper.GetParams(ctx)
 
 	keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
 		bytecode, err := keeper.GetWasm(ctx, codeID)
@@ -82,25 +109,51 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 			state = append(state, m)
 		}
 
-		// redact contract info
-		contract.Created = nil
+		var indexedEventAttributes []types.IndexedEventAttribute
+		keeper.IterateIndexedEventAttributes(ctx, addr, func(attr types.IndexedEventAttribute) bool {
+			indexedEventAttributes = append(indexedEventAttributes, attr)
+			return false
+		})
 
 		genState.Contracts = append(genState.Contracts, types.Contract{
-			ContractAddress:    addr,
-			ContractInfo:       contract,
-			ContractState:      state,
-			ContractCustomInfo: &contractCustomInfo,
+			ContractAddress:        addr,
+			ContractInfo:           contract,
+			ContractState:          state,
+			ContractCustomInfo:     &contractCustomInfo,
+			ContractCodeHistory:    keeper.GetContractHistory(ctx, addr),
+			IndexedEventAttributes: indexedEventAttributes,
 		})
 
 		return false
 	})
 
+	keeper.IterateContractSalts(ctx, func(contractSalt types.ContractSalt) bool {
+		genState.ContractSalts = append(genState.ContractSalts, contractSalt)
+		return false
+	})
+
+	keeper.IteratePendingMigrations(ctx, func(pending types.PendingMigration) bool {
+		genState.PendingMigrations = append(genState.PendingMigrations, pending)
+		return false
+	})
+
+	keeper.IterateExecutionResults(ctx, func(correlated types.CorrelatedExecutionResult) bool {
+		genState.CorrelatedExecutionResults = append(genState.CorrelatedExecutionResults, correlated)
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
 			Value: keeper.peekAutoIncrementID(ctx, k),
 		})
 	}
+	for _, k := range [][]byte{types.KeyCodesCount, types.KeyContractsCount} {
+		genState.Sequences = append(genState.Sequences, types.Sequence{
+			IDKey: k,
+			Value: keeper.getCount(ctx, k),
+		})
+	}
 
 	return &genState
 }
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestImportContractCodeHistory(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	codeInfo := types.CodeInfoFixture()
+	ctx.KVStore(keeper.storeKey).Set(types.GetCodeKey(1), keeper.cdc.MustMarshal(&codeInfo))
+
+	history := []types.ContractCodeHistoryEntry{
+		{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: 2, Updated: &types.AbsoluteTxPosition{BlockHeight: 1, TxIndex: 1}, Msg: []byte("encrypted init")},
+		{Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 1, Updated: &types.AbsoluteTxPosition{BlockHeight: 5, TxIndex: 2}, Msg: []byte("encrypted migrate")},
+	}
+
+	withHistory := contractAddress(1, 1, nil)
+	contractInfo := types.ContractInfoFixture(types.OnlyGenesisFields, func(c *types.ContractInfo) { c.Label = "with history" })
+	err := keeper.importContract(ctx, withHistory, &types.ContractCustomInfo{Label: contractInfo.Label}, &contractInfo, nil, history)
+	require.NoError(t, err)
+	require.Equal(t, history, keeper.GetContractHistory(ctx, withHistory))
+	require.Equal(t, history[0].Updated, keeper.GetContractInfo(ctx, withHistory).Created)
+
+	legacy := contractAddress(1, 2, nil)
+	contractInfo = types.ContractInfoFixture(types.OnlyGenesisFields, func(c *types.ContractInfo) { c.Label = "legacy" })
+	err = keeper.importContract(ctx, legacy, &types.ContractCustomInfo{Label: contractInfo.Label}, &contractInfo, nil, nil)
+	require.NoError(t, err)
+	legacyHistory := keeper.GetContractHistory(ctx, legacy)
+	require.Len(t, legacyHistory, 1)
+	require.Equal(t, types.ContractCodeHistoryOperationTypeGenesis, legacyHistory[0].Operation)
+	require.Equal(t, uint64(1), legacyHistory[0].CodeID)
+	require.Equal(t, legacyHistory[0].Updated, keeper.GetContractInfo(ctx, legacy).Created)
+
+	// an exported created position is kept, with and without code history
+	created := &types.AbsoluteTxPosition{BlockHeight: 3, TxIndex: 4}
+	legacyCreated := contractAddress(1, 3, nil)
+	contractInfo = types.ContractInfoFixture(func(c *types.ContractInfo) { c.Label = "legacy created"; c.Created = created })
+	err = keeper.importContract(ctx, legacyCreated, &types.ContractCustomInfo{Label: contractInfo.Label}, &contractInfo, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, created, keeper.GetContractInfo(ctx, legacyCreated).Created)
+	legacyHistory = keeper.GetContractHistory(ctx, legacyCreated)
+	require.Len(t, legacyHistory, 1)
+	require.Equal(t, created, legacyHistory[0].Updated)
+
+	createdWithHistory := &types.AbsoluteTxPosition{BlockHeight: 2, TxIndex: 1}
+	withHistoryCreated := contractAddress(1, 4, nil)
+	contractInfo = types.ContractInfoFixture(func(c *types.ContractInfo) { c.Label = "with history created"; c.Created = createdWithHistory })
+	err = keeper.importContract(ctx, withHistoryCreated, &types.ContractCustomInfo{Label: contractInfo.Label}, &contractInfo, nil, history)
+	require.NoError(t, err)
+	require.Equal(t, createdWithHistory, keeper.GetContractInfo(ctx, withHistoryCreated).Created)
+
+	// imported contracts are indexed under their creator in the order they were created
+	var contracts []sdk.AccAddress
+	keeper.IterateContractsByCreator(ctx, contractInfo.Creator, func(contractAddress sdk.AccAddress) bool {
+		contracts = append(contracts, contractAddress)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{withHistory, withHistoryCreated, legacyCreated, legacy}, contracts)
+}
+
+func TestGenesisCorrelatedExecutionResults(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, sender := keyPubAddr()
+	results := []types.CorrelatedExecutionResult{
+		{Sender: sender, CorrelationID: "order-1", Result: types.ContractExecutionResult{ContractAddress: contractAddress(1, 1, nil).String(), Data: []byte("encrypted result"), Height: 7}},
+		{Sender: sender, CorrelationID: "order-2", Result: types.ContractExecutionResult{ContractAddress: contractAddress(1, 2, nil).String(), Height: 9}},
+	}
+	for _, correlated := range results {
+		keeper.setExecutionResult(ctx, correlated.Sender, correlated.CorrelationID, correlated.Result)
+	}
+
+	genState := ExportGenesis(ctx, keeper)
+	require.Equal(t, results, genState.CorrelatedExecutionResults)
+	require.NoError(t, genState.ValidateBasic())
+
+	ctx, keepers = CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper = keepers.WasmKeeper
+	require.NoError(t, InitGenesis(ctx, keeper, *genState))
+	require.Equal(t, results, ExportGenesis(ctx, keeper).CorrelatedExecutionResults)
+	require.Equal(t, &results[0].Result, keeper.GetExecutionResultByCorrelationID(ctx, sender, "order-1"))
+
+	// imported results are pruned like recorded ones
+	keeper.PruneExecutionResults(ctx.WithBlockHeight(7 + types.ExecutionResultRetention))
+	require.Nil(t, keeper.GetExecutionResultByCorrelationID(ctx, sender, "order-1"))
+	require.Equal(t, &results[1].Result, keeper.GetExecutionResultByCorrelationID(ctx, sender, "order-2"))
+}
+
+func TestGenesisIndexedEventAttributes(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	codeInfo := types.CodeInfoFixture()
+	ctx.KVStore(keeper.storeKey).Set(types.GetCodeKey(1), keeper.cdc.MustMarshal(&codeInfo))
+
+	addr := contractAddress(1, 1, nil)
+	contractInfo := types.ContractInfoFixture(types.OnlyGenesisFields)
+	customInfo := types.ContractCustomInfo{EnclaveKey: &types.ContractKey{OgContractKey: []byte("key")}, Label: contractInfo.Label}
+	require.NoError(t, keeper.importContract(ctx, addr, &customInfo, &contractInfo, nil, nil))
+	keeper.indexContractEvents(ctx, addr, sdk.Events{
+		sdk.NewEvent(types.CustomEventType,
+			sdk.NewAttribute("indexed:order", "1"),
+			sdk.NewAttribute("indexed:buyer", "alice"),
+		),
+	})
+	attrs := []types.IndexedEventAttribute{
+		{EventType: types.CustomEventType, Key: "order", Value: "1", Height: ctx.BlockHeight()},
+		{EventType: types.CustomEventType, Key: "buyer", Value: "alice", Height: ctx.BlockHeight()},
+	}
+
+	exported := ExportGenesis(ctx, keeper)
+	require.Len(t, exported.Contracts, 1)
+	require.Equal(t, attrs, exported.Contracts[0].IndexedEventAttributes)
+
+	ctx, keepers = CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper = keepers.WasmKeeper
+	ctx.KVStore(keeper.storeKey).Set(types.GetCodeKey(1), keeper.cdc.MustMarshal(&codeInfo))
+	require.NoError(t, InitGenesis(ctx, keeper, types.GenesisState{
+		Params:    types.DefaultParams(),
+		Contracts: exported.Contracts,
+		Sequences: []types.Sequence{{IDKey: types.KeyLastCodeID, Value: 2}, {IDKey: types.KeyLastInstanceID, Value: 2}},
+	}))
+
+	var imported []types.IndexedEventAttribute
+	keeper.IterateIndexedEventAttributes(ctx, addr, func(attr types.IndexedEventAttribute) bool {
+		imported = append(imported, attr)
+		return false
+	})
+	require.Equal(t, attrs, imported)
+
+	// indexing continues after the imported attributes
+	keeper.indexContractEvents(ctx, addr, sdk.Events{
+		sdk.NewEvent(types.CustomEventType, sdk.NewAttribute("indexed:order", "2")),
+	})
+	require.True(t, ctx.KVStore(keeper.storeKey).Has(types.GetIndexedEventAttributeKey(addr, "order", 2)))
+}
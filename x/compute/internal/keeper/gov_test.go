@@ -7,11 +7,15 @@ import (
 	"os"
 	"testing"
 
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 	wasmTypes "github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -169,3 +173,52 @@ func TestGovVote(t *testing.T) {
 	require.Equal(t, govAddr.String(), votes[0].Voter)
 	require.Equal(t, types.OptionYes, votes[0].Option)
 }
+
+// TestContractSubmitProposal tests that a contract can submit a governance proposal as a Stargate message,
+// and that it can only do so when it can pay the initial deposit
+func TestContractSubmitProposal(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	var transferPortSource wasmTypes.ICS20TransferPortSource
+	transferPortSource = MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}}
+	encoders := DefaultEncoders(transferPortSource, encodingConfig.Marshaler)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
+	accKeeper, keeper, govKeeper := keepers.AccountKeeper, keepers.WasmKeeper, keepers.GovKeeper
+
+	msgRouter := baseapp.NewMsgServiceRouter()
+	msgRouter.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	types.RegisterMsgServer(msgRouter, govkeeper.NewMsgServerImpl(govKeeper))
+	handler := NewSDKMessageHandler(msgRouter, nil, encoders, keeper, keeper.bankKeeper)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000))
+	contractAddr, _ := CreateFakeFundedAccount(ctx, accKeeper, keeper.bankKeeper, deposit)
+
+	submitProposal := func(initialDeposit sdk.Coins) error {
+		msg, err := types.NewMsgSubmitProposal(TestProposal, initialDeposit, contractAddr)
+		require.NoError(t, err)
+		bz, err := encodingConfig.Marshaler.Marshal(msg)
+		require.NoError(t, err)
+
+		_, _, err = handler.DispatchMsg(ctx, contractAddr, "", v1wasmTypes.CosmosMsg{
+			Stargate: &v1wasmTypes.StargateMsg{
+				TypeURL: "/cosmos.gov.v1beta1.MsgSubmitProposal",
+				Value:   bz,
+			},
+		})
+		return err
+	}
+
+	err := submitProposal(deposit.Add(sdk.NewInt64Coin("stake", 1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Empty(t, govKeeper.GetProposals(ctx))
+	require.Equal(t, deposit, keeper.bankKeeper.GetAllBalances(ctx, contractAddr))
+
+	err = submitProposal(deposit)
+	require.NoError(t, err)
+	proposals := govKeeper.GetProposals(ctx)
+	require.Len(t, proposals, 1)
+	require.Equal(t, TestProposal.GetTitle(), proposals[0].GetTitle())
+	require.Equal(t, deposit, proposals[0].TotalDeposit)
+	require.True(t, keeper.bankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+}
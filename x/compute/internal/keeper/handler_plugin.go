@@ -16,6 +16,7 @@ import (
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -44,13 +45,29 @@ type SDKMessageHandler struct {
 	// truncated if the msg erred
 	legacyRouter sdk.Router
 	encoders     MessageEncoders
+	// contracts is used to apply the send whitelist of a contract to the bank messages it dispatches
+	contracts ContractInfoSource
+	// balances is used to check that a contract can pay the proposal deposits and donations it dispatches
+	balances BalanceSource
 }
 
-func NewSDKMessageHandler(router MessageRouter, legacyRouter sdk.Router, encoders MessageEncoders) SDKMessageHandler {
+// ContractInfoSource returns the info of a contract, or nil if there is none
+type ContractInfoSource interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
+}
+
+// BalanceSource returns the coins of an account that aren't locked
+type BalanceSource interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+func NewSDKMessageHandler(router MessageRouter, legacyRouter sdk.Router, encoders MessageEncoders, contracts ContractInfoSource, balances BalanceSource) SDKMessageHandler {
 	return SDKMessageHandler{
 		router:       router,
 		legacyRouter: legacyRouter,
 		encoders:     encoders,
+		contracts:    contracts,
+		balances:     balances,
 	}
 }
 
@@ -88,10 +105,12 @@ func NewMessageHandler(
 	capabilityKeeper capabilitykeeper.ScopedKeeper,
 	portSource types.ICS20TransferPortSource,
 	unpacker codectypes.AnyUnpacker,
+	contracts ContractInfoSource,
+	balances BalanceSource,
 ) Messenger {
 	encoders := DefaultEncoders(portSource, unpacker).Merge(customEncoders)
 	return NewMessageHandlerChain(
-		NewSDKMessageHandler(msgRouter, legacyMsgRouter, encoders),
+		NewSDKMessageHandler(msgRouter, legacyMsgRouter, encoders, contracts, balances),
 		NewIBCRawPacketHandler(channelKeeper, ics4Wrapper, capabilityKeeper),
 	)
 }
@@ -568,6 +587,12 @@ func (h SDKMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddr
 		data   [][]byte
 	)
 	for _, sdkMsg := range sdkMsgs {
+		if err := h.checkSendWhitelist(ctx, contractAddr, sdkMsg); err != nil {
+			return nil, nil, err
+		}
+		if err := h.checkSpendableFunds(ctx, contractAddr, sdkMsg); err != nil {
+			return nil, nil, err
+		}
 		res, err := h.handleSdkMessage(ctx, contractAddr, sdkMsg)
 		if err != nil {
 			if res != nil {
@@ -584,11 +609,71 @@ func (h SDKMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddr
 			sdkEvents[i] = sdk.Event(res.Events[i])
 		}
 		events = append(events, sdkEvents...)
+
+		if donation, ok := sdkMsg.(*distrtypes.MsgFundCommunityPool); ok {
+			events = append(events, sdk.NewEvent(
+				types.EventTypeFundCommunityPool,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, donation.Amount.String()),
+			))
+		}
 	}
 
 	return events, data, nil
 }
 
+// checkSendWhitelist rejects bank sends of a contract with a SendWhitelist to addresses that aren't on it.
+// Bank messages encoded as Stargate messages are checked too. Such a contract can't dispatch authz
+// grants and execs either, as they would let it move funds past its whitelist.
+func (h SDKMessageHandler) checkSendWhitelist(ctx sdk.Context, contractAddr sdk.AccAddress, msg sdk.Msg) error {
+	var recipients []string
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		recipients = []string{msg.ToAddress}
+	case *banktypes.MsgMultiSend:
+		for _, output := range msg.Outputs {
+			recipients = append(recipients, output.Address)
+		}
+	case *authz.MsgGrant, *authz.MsgExec:
+	default:
+		return nil
+	}
+
+	contractInfo := h.contracts.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil || len(contractInfo.SendWhitelist) == 0 {
+		return nil
+	}
+	if recipients == nil {
+		return sdkerrors.Wrapf(types.ErrSendNotWhitelisted, "%s can't dispatch %s", contractAddr, sdk.MsgTypeURL(msg))
+	}
+	for _, recipient := range recipients {
+		if !contractInfo.CanSendTo(recipient) {
+			return sdkerrors.Wrapf(types.ErrSendNotWhitelisted, "%s can't send funds to %s", contractAddr, recipient)
+		}
+	}
+	return nil
+}
+
+// checkSpendableFunds rejects governance proposals and community pool donations a contract
+// dispatches as Stargate messages, with itself as the proposer or depositor, when its balance
+// can't pay the initial deposit or the donated amount
+func (h SDKMessageHandler) checkSpendableFunds(ctx sdk.Context, contractAddr sdk.AccAddress, msg sdk.Msg) error {
+	var amount sdk.Coins
+	switch msg := msg.(type) {
+	case *govtypes.MsgSubmitProposal:
+		amount = msg.GetInitialDeposit()
+	case *distrtypes.MsgFundCommunityPool:
+		amount = msg.Amount
+	default:
+		return nil
+	}
+
+	if spendable := h.balances.SpendableCoins(ctx, contractAddr); !spendable.IsAllGTE(amount) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "contract %s can't pay %s of its %s, it has %s", contractAddr, amount, sdk.MsgTypeURL(msg), spendable)
+	}
+	return nil
+}
+
 func (h SDKMessageHandler) handleSdkMessage(ctx sdk.Context, contractAddr sdk.Address, msg sdk.Msg) (*sdk.Result, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
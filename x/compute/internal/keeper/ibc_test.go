@@ -1272,3 +1272,100 @@ func TestIBCPacketTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestIBCPacketReceiveWhitelist(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privkeyA, _, _ := setupTest(t, TestContractPaths[ibcContract], sdk.NewCoins())
+
+	_, _, whitelisted, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privkeyA, `{"init":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	_, _, blocked, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privkeyA, `{"init":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	params := keeper.GetParams(ctx)
+	params.IbcReceiverWhitelist = []string{whitelisted.String()}
+	keeper.setParams(ctx, params)
+
+	packetTo := func(contractAddress sdk.AccAddress) v1types.IBCPacket {
+		return createIBCPacket(createIBCEndpoint(PortIDForContract(contractAddress), "channel.0"),
+			createIBCEndpoint(PortIDForContract(contractAddress), "channel.1"),
+			0,
+			createIBCTimeout(math.MaxUint64),
+			[]byte{},
+		)
+	}
+
+	_, _, _, data, err := ibcPacketReceiveHelper(t, keeper, ctx, whitelisted, walletA, privkeyA, false, defaultGasForIbcTests, packetTo(whitelisted))
+	require.Empty(t, err)
+	require.Equal(t, "\"out\"", string(data))
+
+	_, recvErr := keeper.OnRecvPacket(ctx.WithGasMeter(sdk.NewGasMeter(defaultGasForIbcTests)), blocked, v1types.IBCPacketReceiveMsg{
+		Packet:  packetTo(blocked),
+		Relayer: walletA.String(),
+	})
+	require.ErrorIs(t, recvErr, types.ErrIbcReceiverNotWhitelisted)
+
+	// an empty whitelist allows every contract again
+	params.IbcReceiverWhitelist = nil
+	keeper.setParams(ctx, params)
+
+	_, _, _, data, err = ibcPacketReceiveHelper(t, keeper, ctx, blocked, walletA, privkeyA, false, defaultGasForIbcTests, packetTo(blocked))
+	require.Empty(t, err)
+	require.Equal(t, "\"out\"", string(data))
+}
+
+func TestQueryContractIbcPortAndChannels(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privkeyA, _, _ := setupTest(t, TestContractPaths[ibcContract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privkeyA, `{"init":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	portID := keeper.GetContractInfo(ctx, contractAddress).IBCPortID
+	require.Equal(t, PortIDForContract(contractAddress), portID)
+
+	channel := func(state ibcchanneltypes.State) ibcchanneltypes.Channel {
+		return ibcchanneltypes.Channel{
+			State:          state,
+			Ordering:       ibcchanneltypes.UNORDERED,
+			Counterparty:   ibcchanneltypes.NewCounterparty("counterparty-port", "channel-7"),
+			ConnectionHops: []string{"connection-0"},
+			Version:        "v1",
+		}
+	}
+	keeper.channelKeeper.SetChannel(ctx, portID, "channel-0", channel(ibcchanneltypes.OPEN))
+	keeper.channelKeeper.SetChannel(ctx, portID, "channel-1", channel(ibcchanneltypes.CLOSED))
+	keeper.channelKeeper.SetChannel(ctx, "transfer", "channel-2", channel(ibcchanneltypes.OPEN))
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+	req := &types.QueryByContractAddressRequest{ContractAddress: contractAddress.String()}
+
+	portRsp, err := grpcQuerier.ContractIbcPortId(goCtx, req)
+	require.NoError(t, err)
+	require.Equal(t, portID, portRsp.PortId)
+
+	// only the open channel on the contract's own port
+	channelsRsp, err := grpcQuerier.ContractIbcChannels(goCtx, req)
+	require.NoError(t, err)
+	require.Equal(t, []ibcchanneltypes.IdentifiedChannel{
+		ibcchanneltypes.NewIdentifiedChannel(portID, "channel-0", channel(ibcchanneltypes.OPEN)),
+	}, channelsRsp.Channels)
+
+	// a contract without ibc entry points has neither a port nor channels
+	wasmCode, err := os.ReadFile(TestContractPaths[v1Contract])
+	require.NoError(t, err)
+	plainCodeID, err := keeper.Create(ctx, walletA, wasmCode, "", "")
+	require.NoError(t, err)
+	_, _, plainAddress, _, initErr := initHelper(t, keeper, ctx, plainCodeID, walletA, nil, privkeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	req = &types.QueryByContractAddressRequest{ContractAddress: plainAddress.String()}
+	portRsp, err = grpcQuerier.ContractIbcPortId(goCtx, req)
+	require.NoError(t, err)
+	require.Empty(t, portRsp.PortId)
+	channelsRsp, err = grpcQuerier.ContractIbcChannels(goCtx, req)
+	require.NoError(t, err)
+	require.Empty(t, channelsRsp.Channels)
+
+	_, _, unknownAddress := keyPubAddr()
+	_, err = grpcQuerier.ContractIbcChannels(goCtx, &types.QueryByContractAddressRequest{ContractAddress: unknownAddress.String()})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
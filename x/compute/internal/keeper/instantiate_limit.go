@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// countInstantiate counts an instantiation by creator against the MaxInstantiatesPerBlockPerAccount
+// param. It fails with ErrInstantiateLimitExceeded once creator instantiated that many contracts
+// in the current block, unless creator is one of the InstantiateLimitExempt accounts. The counts
+// aren't charged and live in the tx's store, so instantiations of a failed tx don't count.
+func (k Keeper) countInstantiate(ctx sdk.Context, creator sdk.AccAddress) error {
+	params := types.DefaultParams()
+	k.getParamUnmetered(ctx, types.ParamStoreKeyMaxInstantiatesPerBlockPerAccount, &params.MaxInstantiatesPerBlockPerAccount)
+	if params.MaxInstantiatesPerBlockPerAccount == 0 {
+		return nil
+	}
+	k.getParamUnmetered(ctx, types.ParamStoreKeyInstantiateLimitExempt, &params.InstantiateLimitExempt)
+	if params.IsInstantiateLimitExempt(creator) {
+		return nil
+	}
+
+	store := ctx.MultiStore().GetKVStore(k.storeKey)
+	key := types.GetInstantiateCountKey(creator)
+
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	if count >= params.MaxInstantiatesPerBlockPerAccount {
+		return sdkerrors.Wrapf(types.ErrInstantiateLimitExceeded, "%s instantiated %d contracts in block %d", creator, count, ctx.BlockHeight())
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+	return nil
+}
+
+// ResetInstantiateCounts forgets the instantiations of the block, called at the end of every block
+func (k Keeper) ResetInstantiateCounts(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.MultiStore().GetKVStore(k.storeKey), types.InstantiateCountPrefix)
+
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -72,17 +73,25 @@ type Keeper struct {
 	legacyAmino      codec.LegacyAmino
 	accountKeeper    authkeeper.AccountKeeper
 	bankKeeper       bankkeeper.Keeper
+	stakingKeeper    stakingkeeper.Keeper
 	portKeeper       portkeeper.Keeper
+	channelKeeper    types.ChannelKeeper
 	capabilityKeeper capabilitykeeper.ScopedKeeper
 	wasmer           wasm.Wasmer
 	queryPlugins     QueryPlugins
 	messenger        Messenger
 	// queryGasLimit is the max wasm gas that can be spent on executing a query with a contract
 	queryGasLimit uint64
-	HomeDir       string
+	// queryGasLimitPerMessage is the max gas a single (possibly nested) contract query served to a
+	// client may use, 0 means unlimited. It's node-local, so queries made inside a tx ignore it.
+	queryGasLimitPerMessage uint64
+	HomeDir                 string
 	// authZPolicy   AuthorizationPolicy
-	// paramSpace    subspace.Subspace
+	paramSpace     paramtypes.Subspace
 	LastMsgManager *baseapp.LastMsgMarkerContainer
+	codeMetrics    *codeMetrics
+	// blockComputeGas is the gas the contract messages of the current block used, see MeterBlockComputeGas
+	blockComputeGas *uint64
 }
 
 func moduleLogger(ctx sdk.Context) log.Logger {
@@ -98,6 +107,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	legacyAmino codec.LegacyAmino,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
@@ -124,29 +134,43 @@ func NewKeeper(
 		panic(err)
 	}
 
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	keeper := Keeper{
 		storeKey:         storeKey,
+		paramSpace:       paramSpace,
 		cdc:              cdc,
 		legacyAmino:      legacyAmino,
 		wasmer:           *wasmer,
 		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
+		stakingKeeper:    stakingKeeper,
 		portKeeper:       portKeeper,
+		channelKeeper:    channelKeeper,
 		capabilityKeeper: capabilityKeeper,
-		messenger: NewMessageHandler(
-			msgRouter,
-			legacyMsgRouter,
-			customEncoders,
-			channelKeeper,
-			ics4Wrapper,
-			capabilityKeeper,
-			portSource,
-			cdc,
-		),
-		queryGasLimit:  wasmConfig.SmartQueryGasLimit,
-		HomeDir:        homeDir,
-		LastMsgManager: lastMsgManager,
-	}
+
+		queryGasLimit:           wasmConfig.SmartQueryGasLimit,
+		queryGasLimitPerMessage: wasmConfig.QueryGasLimitPerMessage,
+		HomeDir:                 homeDir,
+		LastMsgManager:          lastMsgManager,
+		codeMetrics:             newCodeMetrics(int(wasmConfig.MetricsTrackedCodes)),
+		blockComputeGas:         new(uint64),
+	}
+	keeper.messenger = NewMessageHandler(
+		msgRouter,
+		legacyMsgRouter,
+		customEncoders,
+		channelKeeper,
+		ics4Wrapper,
+		capabilityKeeper,
+		portSource,
+		cdc,
+		&keeper,
+		bankKeeper,
+	)
 	keeper.queryPlugins = DefaultQueryPlugins(govKeeper, distKeeper, mintKeeper, bankKeeper, stakingKeeper, queryRouter, &keeper, channelKeeper).Merge(customPlugins)
 
 	return keeper
@@ -156,8 +180,40 @@ func (k Keeper) GetLastMsgMarkerContainer() *baseapp.LastMsgMarkerContainer {
 	return k.LastMsgManager
 }
 
+// GetParams returns the total set of compute parameters.
+// Chains that predate the compute params have none stored, in which case the defaults are returned.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// getParamUnmetered reads the single param stored under key into ptr, which holds the default to
+// keep when the param isn't set. The read isn't charged, so params read on every contract call
+// don't change the gas contracts use.
+func (k Keeper) getParamUnmetered(ctx sdk.Context, key []byte, ptr interface{}) {
+	k.paramSpace.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), key, ptr)
+}
+
+func (k Keeper) setParams(ctx sdk.Context, ps types.Params) {
+	k.paramSpace.SetParamSet(ctx, &ps)
+}
+
 // Create uploads and compiles a WASM contract, returning a short identifier for the contract
 func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string) (codeID uint64, err error) {
+	return k.CreateWithOptions(ctx, creator, wasmCode, source, builder, CreateOptions{})
+}
+
+// CreateOptions are the optional settings of stored code
+type CreateOptions struct {
+	// InstantiatePermission decides who may instantiate contracts from the code, everybody when nil
+	InstantiatePermission *types.AccessConfig
+	// SourceChecksum is the sha256 checksum of the source archive at source
+	SourceChecksum []byte
+}
+
+// CreateWithOptions stores the given wasm code like Create, with the settings in opts
+func (k Keeper) CreateWithOptions(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, source string, builder string, opts CreateOptions) (codeID uint64, err error) {
 	wasmCode, err = uncompress(wasmCode)
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
@@ -171,9 +227,15 @@ func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	store := ctx.KVStore(k.storeKey)
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
 
-	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder)
+	instantiatePermission := opts.InstantiatePermission
+	if instantiatePermission == nil {
+		instantiatePermission = &types.AllowEverybody
+	}
+	codeInfo := types.NewCodeInfo(codeHash, creator, source, builder, *instantiatePermission)
+	codeInfo.SourceChecksum = opts.SourceChecksum
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(&codeInfo))
+	k.incrementCount(ctx, types.KeyCodesCount)
 
 	return codeID, nil
 }
@@ -196,6 +258,10 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	if store.Has(key) {
 		return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
+	// Codes exported before instantiate permissions existed were open to everybody
+	if codeInfo.InstantiateConfig.Permission == types.AccessTypeUndefined {
+		codeInfo.InstantiateConfig = types.AllowEverybody
+	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshal(&codeInfo))
 	return nil
@@ -402,12 +468,43 @@ func V010MsgsToV1SubMsgs(contractAddr string, msgs []v010wasmTypes.CosmosMsg) ([
 	return subMsgs, nil
 }
 
+// InstantiateOptions are the optional settings of a new contract instance, the zero value gives a
+// plain contract
+type InstantiateOptions struct {
+	// Salt makes the address of the contract predictable, see PredictContractAddress. A creator can
+	// use each salt only once per code.
+	Salt []byte
+	// ReentrancyProtected makes the contract reject executions starting while another execution of
+	// it hasn't finished yet with ErrReentrancy. Queries of the contract are still allowed.
+	ReentrancyProtected bool
+	// SendWhitelist, when not empty, holds the only addresses the contract can send funds to
+	SendWhitelist []string
+}
+
 // Instantiate creates an instance of a WASM contract
 func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, callbackSig []byte) (sdk.AccAddress, []byte, error) {
+	return k.InstantiateWithOptions(ctx, codeID, creator, admin, initMsg, label, deposit, callbackSig, InstantiateOptions{})
+}
+
+// InstantiateWithOptions creates an instance of a WASM contract with the settings in opts
+func (k Keeper) InstantiateWithOptions(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, callbackSig []byte, opts InstantiateOptions) (sdk.AccAddress, []byte, error) {
+	salt, reentrancyProtected, sendWhitelist := opts.Salt, opts.ReentrancyProtected, opts.SendWhitelist
+	if len(salt) == 0 {
+		salt = nil
+	}
+
 	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "instantiate")
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: init")
 
+	if err := types.ValidateContractMsgSize(initMsg, k.maxContractMsgSize(ctx)); err != nil {
+		return nil, nil, err
+	}
+
+	if err := k.countInstantiate(ctx, creator); err != nil {
+		return nil, nil, err
+	}
+
 	signBytes := []byte{}
 	signMode := sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED
 	modeInfoBytes := []byte{}
@@ -431,15 +528,37 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	existingAddress := store.Get(types.GetContractLabelPrefix(label))
 
 	if existingAddress != nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, label)
+		return nil, nil, sdkerrors.Wrapf(types.ErrAccountExists, "label %s is already used by contract %s, labels must be unique", label, sdk.AccAddress(existingAddress))
 	}
 
-	contractAddress := k.generateContractAddress(ctx, codeID, creator)
+	var contractAddress sdk.AccAddress
+	if salt == nil {
+		contractAddress = k.generateContractAddress(ctx, codeID, creator)
+	} else {
+		if existingAddress := store.Get(types.GetContractSaltKey(codeID, creator, salt)); existingAddress != nil {
+			return nil, nil, sdkerrors.Wrapf(types.ErrDuplicate, "salt %X was already used by %s for code %d", salt, creator, codeID)
+		}
+		// the instance counter still has to move, it bounds the number of contracts on genesis import
+		k.autoIncrementID(ctx, types.KeyLastInstanceID)
+		contractAddress = PredictContractAddress(codeID, creator, salt)
+		if k.containsContractInfo(ctx, contractAddress) {
+			return nil, nil, sdkerrors.Wrap(types.ErrDuplicate, "contract address collision")
+		}
+	}
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
 	if existingAcct != nil {
 		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
 	}
 
+	if reentrancyProtected {
+		// the contract exists as soon as its init returns, so the messages it dispatches can't call back into it either
+		var err error
+		ctx, err = enterContract(ctx, contractAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// deposit initial contract funds
 	if !deposit.IsZero() {
 		if k.bankKeeper.BlockedAddr(creator) {
@@ -464,6 +583,10 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(bz, &codeInfo)
 
+	if !codeInfo.InstantiateConfig.Allowed(creator) {
+		return nil, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to instantiate contracts from code %d", creator, codeID)
+	}
+
 	random := k.GetRandomSeed(ctx, ctx.BlockHeight())
 
 	// prepare env for contract instantiate call
@@ -483,6 +606,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	// 0x03 | contractAddress (sdk.AccAddress)
 	prefixStoreKey := types.GetContractStorePrefixKey(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	storage := k.newContractStorage(ctx, contractAddress, prefixStore)
 
 	// prepare querier
 	querier := QueryHandler{
@@ -491,8 +615,12 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		Caller:  contractAddress,
 	}
 
-	response, ogContractKey, adminProof, gasUsed, initError := k.wasmer.Instantiate(codeInfo.CodeHash, env, initMsg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), sigInfo, admin)
-	consumeGas(ctx, gasUsed)
+	response, ogContractKey, adminProof, gasUsed, initError := k.wasmer.Instantiate(codeInfo.CodeHash, env, initMsg, storage, cosmwasmAPI, querier, ctx.GasMeter(), k.gasForContract(ctx), sigInfo, admin)
+	k.consumeGas(ctx, gasUsed)
+
+	if err := k.commitContractStorage(ctx, contractAddress, storage); err != nil {
+		return contractAddress, nil, err
+	}
 
 	if initError != nil {
 		switch res := response.(type) { //nolint:gocritic
@@ -507,6 +635,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 
 		return contractAddress, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, initError.Error())
 	}
+	k.refundFreedStorageGas(ctx, storage)
 
 	switch res := response.(type) {
 	case *v010wasmTypes.InitResponse:
@@ -515,10 +644,12 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		// persist instance
 		createdAt := types.NewAbsoluteTxPosition(ctx)
 		contractInfo := types.NewContractInfo(codeID, creator, admin.String(), adminProof, label, createdAt)
+		contractInfo.ReentrancyProtected = reentrancyProtected
+		contractInfo.SendWhitelist = sendWhitelist
 
 		historyEntry := contractInfo.InitialHistory(initMsg)
 		k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
-		// k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
+		k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
 		k.appendToContractHistory(ctx, contractAddress, historyEntry)
 
 		k.setContractInfo(ctx, contractAddress, &contractInfo)
@@ -528,6 +659,10 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 			CurrentContractKeyProof: nil,
 		})
 		store.Set(types.GetContractLabelPrefix(label), contractAddress)
+		k.incrementCount(ctx, types.KeyContractsCount)
+		if salt != nil {
+			k.setContractSalt(ctx, types.ContractSalt{CodeID: codeID, Creator: creator, Salt: salt, ContractAddress: contractAddress})
+		}
 
 		subMessages, err := V010MsgsToV1SubMsgs(contractAddress.String(), res.Messages)
 		if err != nil {
@@ -544,6 +679,8 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		// persist instance first
 		createdAt := types.NewAbsoluteTxPosition(ctx)
 		contractInfo := types.NewContractInfo(codeID, creator, admin.String(), adminProof, label, createdAt)
+		contractInfo.ReentrancyProtected = reentrancyProtected
+		contractInfo.SendWhitelist = sendWhitelist
 
 		// check for IBC flag
 		report, err := k.wasmer.AnalyzeCode(codeInfo.CodeHash)
@@ -567,7 +704,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 
 		historyEntry := contractInfo.InitialHistory(initMsg)
 		k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
-		// k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
+		k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
 		k.appendToContractHistory(ctx, contractAddress, historyEntry)
 
 		// persist instance
@@ -578,6 +715,10 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 			CurrentContractKeyProof: nil,
 		})
 		store.Set(types.GetContractLabelPrefix(label), contractAddress)
+		k.incrementCount(ctx, types.KeyContractsCount)
+		if salt != nil {
+			k.setContractSalt(ctx, types.ContractSalt{CodeID: codeID, Creator: creator, Salt: salt, ContractAddress: contractAddress})
+		}
 
 		data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Events, res.Data, initMsg, sigInfo)
 		if err != nil {
@@ -596,6 +737,10 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading Compute module: execute")
 
+	if err := types.ValidateContractMsgSize(msg, k.maxContractMsgSize(ctx)); err != nil {
+		return nil, err
+	}
+
 	signBytes := []byte{}
 	signMode := sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED
 	modeInfoBytes := []byte{}
@@ -617,6 +762,18 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.Terminated {
+		return nil, sdkerrors.Wrap(types.ErrContractTerminated, contractAddress.String())
+	}
+	if contractInfo.Paused {
+		return nil, sdkerrors.Wrap(types.ErrContractPaused, contractAddress.String())
+	}
+	if contractInfo.ReentrancyProtected {
+		ctx, err = enterContract(ctx, contractAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// add more funds
 	if !coins.IsZero() {
@@ -646,8 +803,21 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		Caller:  contractAddress,
 	}
 
-	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, handleType)
-	consumeGas(ctx, gasUsed)
+	var contractStore sdk.KVStore = prefixStore
+	readOnly := newReadOnlyStore(ctx, prefixStore)
+	if readOnly != nil {
+		contractStore = readOnly
+	}
+
+	storage := k.newContractStorage(ctx, contractAddress, contractStore)
+	start := time.Now()
+	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, msg, storage, cosmwasmAPI, querier, gasMeter(ctx), k.gasForContract(ctx), sigInfo, handleType)
+	k.consumeGas(ctx, gasUsed)
+	k.codeMetrics.recordExecution(contractInfo.CodeID, k.contractGasCost(ctx, gasUsed), start)
+
+	if err := k.commitContractStorage(ctx, contractAddress, storage); err != nil {
+		return nil, err
+	}
 
 	if execErr != nil {
 		var result sdk.Result
@@ -662,6 +832,11 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 		return &result, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	k.refundFreedStorageGas(ctx, storage)
+
+	if err := readOnly.check(contractAddress, response); err != nil {
+		return nil, err
+	}
 
 	switch res := response.(type) {
 	case *v010wasmTypes.HandleResponse:
@@ -697,9 +872,14 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	}
 }
 
-// QuerySmart queries the smart contract itself.
+// clientQueryKey is the context key QuerySmart marks the queries it serves to clients with
+type clientQueryKey struct{}
+
+// QuerySmart queries the smart contract itself. It serves the gRPC and legacy queries of clients,
+// so it and the contract-to-contract queries it triggers are subject to the per-message query
+// gas limit of the node.
 func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte, useDefaultGasLimit bool) ([]byte, error) {
-	return k.querySmartImpl(ctx, contractAddr, req, useDefaultGasLimit, 1)
+	return k.querySmartImpl(ctx.WithValue(clientQueryKey{}, true), contractAddr, req, useDefaultGasLimit, 1)
 }
 
 // QuerySmartRecursive queries the smart contract itself. This should only be called when running inside another query recursively.
@@ -716,10 +896,13 @@ func (k Keeper) querySmartImpl(ctx sdk.Context, contractAddress sdk.AccAddress,
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: query")
 
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.Terminated {
+		return nil, sdkerrors.Wrap(types.ErrContractTerminated, contractAddress.String())
+	}
 
 	// prepare querier
 	querier := QueryHandler{
@@ -743,12 +926,17 @@ func (k Keeper) querySmartImpl(ctx sdk.Context, contractAddress sdk.AccAddress,
 	)
 	params.QueryDepth = queryDepth
 
-	queryResult, gasUsed, qErr := k.wasmer.Query(codeInfo.CodeHash, params, req, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx))
-	consumeGas(ctx, gasUsed)
+	gasLimit, limitedPerMessage := k.queryGasForContract(ctx)
+
+	queryResult, gasUsed, qErr := k.wasmer.Query(codeInfo.CodeHash, params, req, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasLimit)
+	k.consumeGas(ctx, gasUsed)
 
 	telemetry.SetGauge(float32(gasUsed), "compute", "keeper", "query", contractAddress.String(), "gasUsed")
 
 	if qErr != nil {
+		if _, ok := qErr.(wasmTypes.OutOfGasError); ok && limitedPerMessage {
+			return nil, sdkerrors.Wrapf(types.ErrGasLimit, "query exceeded the per-message gas limit of %d", k.queryGasLimitPerMessage)
+		}
 		return nil, sdkerrors.Wrap(types.ErrQueryFailed, qErr.Error())
 	}
 	return queryResult, nil
@@ -773,6 +961,22 @@ func (k Keeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []
 	return result
 }
 
+// GetContractStateByKey returns the value stored under the given raw key of the contract's state.
+// The value is returned as written by the enclave, i.e. it is still encrypted.
+// A key that isn't set returns an empty value and no error.
+func (k Keeper) GetContractStateByKey(ctx sdk.Context, contractAddress sdk.AccAddress, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "key")
+	}
+	if !k.containsContractInfo(ctx, contractAddress) {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+
+	prefixStoreKey := types.GetContractStorePrefixKey(contractAddress)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	return prefixStore.Get(key), nil
+}
+
 func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, prefix.Store, error) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -876,6 +1080,70 @@ func (k Keeper) containsContractInfo(ctx sdk.Context, contractAddress sdk.AccAdd
 	return store.Has(types.GetContractAddressKey(contractAddress))
 }
 
+// SetContractPaused pauses or resumes all executions of a contract. Only the contract admin can do this.
+func (k Keeper) SetContractPaused(ctx sdk.Context, contractAddress, caller sdk.AccAddress, paused bool) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if contractInfo.Admin == "" || contractInfo.Admin != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the admin")
+	}
+	if contractInfo.Paused == paused {
+		if paused {
+			return sdkerrors.Wrap(types.ErrInvalid, "contract is already paused")
+		}
+		return sdkerrors.Wrap(types.ErrInvalid, "contract is not paused")
+	}
+
+	contractInfo.Paused = paused
+	k.setContractInfo(ctx, contractAddress, contractInfo)
+	return nil
+}
+
+// TerminateContract sends the whole balance of a contract to recipient and marks the contract as
+// terminated, after which it can't be executed, migrated, queried, replied to or called over IBC
+// anymore. Only the contract itself can terminate it, by dispatching MsgTerminateContract, and
+// recipient has to be on its SendWhitelist, if it has one. Its state is kept, its event attribute
+// index is removed.
+//
+// The contract's account stays a regular account of the bank module, which has no hook to reject
+// incoming sends, so bank sends to a terminated contract still succeed. Nothing can move those
+// funds anymore: they are locked for good. Executions that send funds are rejected like any other.
+func (k Keeper) TerminateContract(ctx sdk.Context, contractAddress, recipient sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if contractInfo.Terminated {
+		return sdkerrors.Wrap(types.ErrContractTerminated, contractAddress.String())
+	}
+
+	balance := k.bankKeeper.GetAllBalances(ctx, contractAddress)
+	if !balance.IsZero() {
+		if k.bankKeeper.BlockedAddr(recipient) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocked address can not be used")
+		}
+		if !contractInfo.CanSendTo(recipient.String()) {
+			return sdkerrors.Wrapf(types.ErrSendNotWhitelisted, "%s can't send funds to %s", contractAddress, recipient)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, contractAddress, recipient, balance); err != nil {
+			return err
+		}
+	}
+
+	contractInfo.Terminated = true
+	k.setContractInfo(ctx, contractAddress, contractInfo)
+	k.deleteIndexedEventAttributes(ctx, contractAddress)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTerminate,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+	))
+	return nil
+}
+
 func (k Keeper) setContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, contract *types.ContractInfo) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetContractAddressKey(contractAddress), k.cdc.MustMarshal(contract))
@@ -924,6 +1192,7 @@ func (k Keeper) GetContractState(ctx sdk.Context, contractAddress sdk.AccAddress
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
 	prefixStoreKey := types.GetContractStorePrefixKey(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	var used uint64
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
@@ -933,8 +1202,9 @@ func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddr
 			return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
 		}
 		prefixStore.Set(model.Key, model.Value)
-
+		used += entrySize(model.Key, model.Value)
 	}
+	k.setContractStorageUsage(ctx, contractAddress, used)
 	return nil
 }
 
@@ -996,6 +1266,7 @@ func (k *Keeper) handleContractResponse(
 	events := types.ContractLogsToSdkEvents(logs, contractAddr)
 
 	ctx.EventManager().EmitEvents(events)
+	k.indexContractEvents(ctx, contractAddr, events)
 
 	if len(evts) > 0 {
 
@@ -1005,23 +1276,47 @@ func (k *Keeper) handleContractResponse(
 		}
 
 		ctx.EventManager().EmitEvents(customEvents)
+		k.indexContractEvents(ctx, contractAddr, customEvents)
 	}
 
 	responseHandler := NewContractResponseHandler(NewMessageDispatcher(k.messenger, k))
 	return responseHandler.Handle(ctx, contractAddr, ibcPort, msgs, data, ogTx, ogSigInfo)
 }
 
-func gasForContract(ctx sdk.Context) uint64 {
+// gasForContract returns the wasm gas a contract may use with the gas left in ctx, once it is
+// multiplied by the ComputeGasMultiplier param
+func (k Keeper) gasForContract(ctx sdk.Context) uint64 {
 	meter := ctx.GasMeter()
-	remaining := (meter.Limit() - meter.GasConsumed()) * types.GasMultiplier
+	remaining := (meter.Limit() - meter.GasConsumed()) / k.computeGasMultiplier(ctx) * types.GasMultiplier
 	if remaining > types.MaxGas {
 		return types.MaxGas
 	}
 	return remaining
 }
 
-func consumeGas(ctx sdk.Context, gas uint64) {
-	consumed := (gas / types.GasMultiplier) + 1
+// queryGasForContract returns the wasm gas available to a single contract query, and whether the per-message
+// query gas limit is what bounds it. The limit only applies to queries served to clients: it's read from
+// app.toml, and queries made inside a tx must use the same gas on every validator.
+func (k Keeper) queryGasForContract(ctx sdk.Context) (uint64, bool) {
+	remaining := k.gasForContract(ctx)
+	clientQuery, _ := ctx.Value(clientQueryKey{}).(bool)
+	if !clientQuery || k.queryGasLimitPerMessage == 0 {
+		return remaining, false
+	}
+
+	limit := k.queryGasLimitPerMessage / k.computeGasMultiplier(ctx) * types.GasMultiplier
+	if limit > types.MaxGas {
+		limit = types.MaxGas
+	}
+	if limit < remaining {
+		return limit, true
+	}
+	return remaining, false
+}
+
+// consumeGas charges ctx for the wasm gas a contract used, see contractGasCost
+func (k Keeper) consumeGas(ctx sdk.Context, gas uint64) {
+	consumed := k.contractGasCost(ctx, gas)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
@@ -1029,12 +1324,80 @@ func consumeGas(ctx sdk.Context, gas uint64) {
 	}
 }
 
+// contractGasCost converts the wasm gas a contract used to the sdk gas it is charged, multiplied by
+// the ComputeGasMultiplier param
+func (k Keeper) contractGasCost(ctx sdk.Context, gas uint64) uint64 {
+	return ((gas / types.GasMultiplier) + 1) * k.computeGasMultiplier(ctx)
+}
+
+// maxContractMsgSize returns the MaxContractMsgSize param
+func (k Keeper) maxContractMsgSize(ctx sdk.Context) uint64 {
+	size := types.DefaultParams().MaxContractMsgSize
+	k.getParamUnmetered(ctx, types.ParamStoreKeyMaxContractMsgSize, &size)
+	return size
+}
+
+// isIbcReceiverWhitelisted checks contractAddress against the IbcReceiverWhitelist param
+func (k Keeper) isIbcReceiverWhitelisted(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	params := types.DefaultParams()
+	k.getParamUnmetered(ctx, types.ParamStoreKeyIbcReceiverWhitelist, &params.IbcReceiverWhitelist)
+	return params.IsIbcReceiverWhitelisted(contractAddress)
+}
+
+// computeGasMultiplier returns the ComputeGasMultiplier param, or its default when it's zero
+func (k Keeper) computeGasMultiplier(ctx sdk.Context) uint64 {
+	var multiplier uint64
+	k.getParamUnmetered(ctx, types.ParamStoreKeyComputeGasMultiplier, &multiplier)
+	if multiplier == 0 {
+		return types.DefaultComputeGasMultiplier
+	}
+	return multiplier
+}
+
 // generates a contract address from codeID + instanceID
 func (k Keeper) generateContractAddress(ctx sdk.Context, codeID uint64, creator sdk.AccAddress) sdk.AccAddress {
 	instanceID := k.autoIncrementID(ctx, types.KeyLastInstanceID)
 	return contractAddress(codeID, instanceID, creator)
 }
 
+// PredictContractAddress returns the address of a contract instantiated from code codeID by creator with
+// the given salt. Unlike instance counter based addresses it is known before the contract is instantiated.
+// The init message isn't part of the address: it is encrypted with a new nonce and key for every
+// transaction, so its hash isn't known before the transaction is built. A creator can use each salt only
+// once per code, which keeps the addresses unique without it.
+func PredictContractAddress(codeID uint64, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+	hashSourceBytes := []byte(types.ModuleName + "/instantiate2")
+	hashSourceBytes = append(hashSourceBytes, sdk.Uint64ToBigEndian(codeID)...)
+	hashSourceBytes = append(hashSourceBytes, byte(len(creator)))
+	hashSourceBytes = append(hashSourceBytes, creator...)
+	hashSourceBytes = append(hashSourceBytes, byte(len(salt)))
+	hashSourceBytes = append(hashSourceBytes, salt...)
+
+	sha := sha256.Sum256(hashSourceBytes)
+	hasherRIPEMD160 := ripemd160.New()
+	hasherRIPEMD160.Write(sha[:]) // does not error
+	return sdk.AccAddress(hasherRIPEMD160.Sum(nil))
+}
+
+func (k Keeper) setContractSalt(ctx sdk.Context, contractSalt types.ContractSalt) {
+	ctx.KVStore(k.storeKey).Set(types.GetContractSaltKey(contractSalt.CodeID, contractSalt.Creator, contractSalt.Salt), k.cdc.MustMarshal(&contractSalt))
+}
+
+// IterateContractSalts calls cb for every salt a contract was instantiated with
+func (k Keeper) IterateContractSalts(ctx sdk.Context, cb func(types.ContractSalt) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractSaltPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var contractSalt types.ContractSalt
+		k.cdc.MustUnmarshal(iter.Value(), &contractSalt)
+		// cb returns true to stop early
+		if cb(contractSalt) {
+			break
+		}
+	}
+}
+
 func contractAddress(codeID, instanceID uint64, creator sdk.AccAddress) sdk.AccAddress {
 	contractId := codeID<<32 + instanceID
 	hashSourceBytes := make([]byte, 8)
@@ -1093,13 +1456,27 @@ func (k Keeper) importAutoIncrementID(ctx sdk.Context, lastIDKey []byte, val uin
 	return nil
 }
 
-func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, customInfo *types.ContractCustomInfo, c *types.ContractInfo, state []types.Model) error {
+func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, customInfo *types.ContractCustomInfo, c *types.ContractInfo, state []types.Model, historyEntries []types.ContractCodeHistoryEntry) error {
 	if !k.containsCodeInfo(ctx, c.CodeID) {
 		return sdkerrors.Wrapf(types.ErrNotFound, "code id: %d", c.CodeID)
 	}
 	if k.containsContractInfo(ctx, contractAddr) {
 		return sdkerrors.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
 	}
+	if existingAddress := k.GetContractAddress(ctx, c.Label); existingAddress != nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "label %s of contract %s is already used by contract %s", c.Label, contractAddr, existingAddress)
+	}
+
+	if len(historyEntries) == 0 {
+		// exports that predate code history in genesis start the history at the import
+		historyEntries = []types.ContractCodeHistoryEntry{c.ResetFromGenesis(ctx)}
+	} else if c.Created == nil {
+		created := *historyEntries[0].Updated
+		c.Created = &created
+	}
+	k.appendToContractHistory(ctx, contractAddr, historyEntries...)
+	k.addToContractCodeSecondaryIndex(ctx, contractAddr, historyEntries[len(historyEntries)-1])
+	k.addToContractCreatorSecondaryIndex(ctx, c.Creator, c.Created, contractAddr)
 
 	k.setContractCustomInfo(ctx, contractAddr, customInfo)
 	k.setContractInfo(ctx, contractAddr, c)
@@ -1156,6 +1533,9 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply v1w
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.Terminated {
+		return nil, sdkerrors.Wrap(types.ErrContractTerminated, contractAddress.String())
+	}
 
 	// always consider this pinned
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading Compute module: reply")
@@ -1183,18 +1563,24 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply v1w
 		return nil, err
 	}
 
-	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, marshaledReply, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), ogSigInfo, wasmTypes.HandleTypeReply)
-	consumeGas(ctx, gasUsed)
+	storage := k.newContractStorage(ctx, contractAddress, prefixStore)
+	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, marshaledReply, storage, cosmwasmAPI, querier, ctx.GasMeter(), k.gasForContract(ctx), ogSigInfo, wasmTypes.HandleTypeReply)
+	k.consumeGas(ctx, gasUsed)
+
+	if err := k.commitContractStorage(ctx, contractAddress, storage); err != nil {
+		return nil, err
+	}
 
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrReplyFailed, execErr.Error())
 	}
+	k.refundFreedStorageGas(ctx, storage)
 
 	switch res := response.(type) {
 	case *v010wasmTypes.HandleResponse:
 		return nil, sdkerrors.Wrap(types.ErrReplyFailed, fmt.Sprintf("response of reply should always be a CosmWasm v1 response type: %+v", res))
 	case *v1wasmTypes.Response:
-		consumeGas(ctx, gasUsed)
+		k.consumeGas(ctx, gasUsed)
 
 		ctx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypeReply,
@@ -1223,6 +1609,10 @@ func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, ne
 	if contractInfo.Admin != caller.String() {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the admin")
 	}
+	// a contract that is its own admin could migrate itself at will
+	if newAdmin.Equals(contractAddress) {
+		return sdkerrors.Wrap(types.ErrInvalid, "new admin cannot be the contract itself")
+	}
 
 	signBytes := []byte{}
 	signMode := sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED
@@ -1264,7 +1654,7 @@ func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, ne
 		Caller:  contractAddress,
 	}
 
-	newAdminProof, updateAdminErr := k.wasmer.UpdateAdmin(codeInfo.CodeHash, env, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, currentAdminAddress, contractInfo.AdminProof, newAdmin)
+	newAdminProof, updateAdminErr := k.wasmer.UpdateAdmin(codeInfo.CodeHash, env, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), k.gasForContract(ctx), sigInfo, currentAdminAddress, contractInfo.AdminProof, newAdmin)
 
 	if updateAdminErr != nil {
 		return updateAdminErr
@@ -1308,6 +1698,9 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, sdkerrors.Wrap(err, "unknown contract").Error())
 	}
+	if contractInfo.Terminated {
+		return nil, sdkerrors.Wrap(types.ErrContractTerminated, contractAddress.String())
+	}
 
 	newCodeInfo, err := k.GetCodeInfo(ctx, newCodeID)
 	if err != nil {
@@ -1338,6 +1731,9 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if contractInfo.Admin != caller.String() {
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, "requires migrate from admin")
 	}
+	if err := k.consumePendingMigration(ctx, contractAddress, contractInfo, newCodeID); err != nil {
+		return nil, err
+	}
 
 	random := k.GetRandomSeed(ctx, ctx.BlockHeight())
 
@@ -1358,8 +1754,13 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		Caller:  contractAddress,
 	}
 
-	response, newContractKey, newContractKeyProof, gasUsed, migrateErr := k.wasmer.Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, adminAddr, adminProof)
-	consumeGas(ctx, gasUsed)
+	storage := k.newContractStorage(ctx, contractAddress, prefixStore)
+	response, newContractKey, newContractKeyProof, gasUsed, migrateErr := k.wasmer.Migrate(newCodeInfo.CodeHash, env, msg, storage, cosmwasmAPI, querier, gasMeter(ctx), k.gasForContract(ctx), sigInfo, adminAddr, adminProof)
+	k.consumeGas(ctx, gasUsed)
+
+	if err := k.commitContractStorage(ctx, contractAddress, storage); err != nil {
+		return nil, err
+	}
 
 	if migrateErr != nil {
 		var result []byte
@@ -1374,6 +1775,7 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 		return result, sdkerrors.Wrap(types.ErrMigrationFailed, migrateErr.Error())
 	}
+	k.refundFreedStorageGas(ctx, storage)
 
 	// update contract key with new one
 	k.SetContractKey(ctx, contractAddress, &types.ContractKey{
@@ -1482,6 +1884,26 @@ func (k Keeper) addToContractCodeSecondaryIndex(ctx sdk.Context, contractAddress
 	store.Set(types.GetContractByCreatedSecondaryIndexKey(contractAddress, entry), []byte{})
 }
 
+// addToContractCreatorSecondaryIndex adds element to the index for contracts-by-creator queries
+func (k Keeper) addToContractCreatorSecondaryIndex(ctx sdk.Context, creator sdk.AccAddress, created *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByCreatorSecondaryIndexKey(creator, created, contractAddress), []byte{})
+}
+
+// IterateContractsByCreator iterates over the contracts creator instantiated, ordered by the time
+// they were instantiated
+func (k Keeper) IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(contractAddress sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByCreatorPrefix(creator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()[types.AbsoluteTxPositionLen:]) {
+			return
+		}
+	}
+}
+
 func (k Keeper) GetStoreKey() sdk.StoreKey {
 	return k.storeKey
 }
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+const (
+	metricsLabelCodeID = "code_id"
+	// metricsOtherCodes is the code_id label of every code that isn't tracked on its own
+	metricsOtherCodes = "other"
+)
+
+// codeMetrics reports execution metrics labeled by code ID. Only the codes that used the most
+// gas since the node started get a label of their own, every other code is reported as "other",
+// so the number of metric series stays bounded no matter how many codes are stored.
+//
+// This is node local state and never affects consensus.
+type codeMetrics struct {
+	mu      sync.Mutex
+	limit   int
+	gasUsed map[uint64]uint64
+	tracked map[uint64]struct{}
+}
+
+func newCodeMetrics(limit int) *codeMetrics {
+	return &codeMetrics{
+		limit:   limit,
+		gasUsed: make(map[uint64]uint64),
+		tracked: make(map[uint64]struct{}, limit),
+	}
+}
+
+// label adds gasUsed to the total of codeID and returns the code_id label to report it under.
+// Once all slots are taken, a code replaces the tracked code with the lowest total when it
+// used more gas than that one.
+func (m *codeMetrics) label(codeID uint64, gasUsed uint64) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.gasUsed[codeID] += gasUsed
+
+	if _, ok := m.tracked[codeID]; !ok {
+		if len(m.tracked) >= m.limit {
+			lowest, found := uint64(0), false
+			for tracked := range m.tracked {
+				if !found || m.gasUsed[tracked] < m.gasUsed[lowest] {
+					lowest, found = tracked, true
+				}
+			}
+			if !found || m.gasUsed[codeID] <= m.gasUsed[lowest] {
+				return metricsOtherCodes
+			}
+			delete(m.tracked, lowest)
+		}
+		m.tracked[codeID] = struct{}{}
+	}
+
+	return strconv.FormatUint(codeID, 10)
+}
+
+// recordExecution reports a contract execution of codeID that used gasUsed and started at start
+func (m *codeMetrics) recordExecution(codeID uint64, gasUsed uint64, start time.Time) {
+	labels := []metrics.Label{telemetry.NewLabel(metricsLabelCodeID, m.label(codeID, gasUsed))}
+
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, "execute", "calls"}, 1, labels)
+	telemetry.IncrCounterWithLabels([]string{types.ModuleName, "execute", "gas_used"}, float32(gasUsed), labels)
+	metrics.MeasureSinceWithLabels([]string{types.ModuleName, "execute", "duration"}, start.UTC(), labels)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCodeMetricsLabel(t *testing.T) {
+	m := newCodeMetrics(2)
+
+	require.Equal(t, "1", m.label(1, 100))
+	require.Equal(t, "2", m.label(2, 50))
+
+	// all slots are taken and code 3 used less gas than the tracked codes
+	require.Equal(t, metricsOtherCodes, m.label(3, 10))
+
+	// code 3 overtakes code 2, which is reported as "other" from now on
+	require.Equal(t, "3", m.label(3, 60))
+	require.Equal(t, metricsOtherCodes, m.label(2, 1))
+	require.Equal(t, "1", m.label(1, 1))
+
+	require.Len(t, m.tracked, 2)
+}
+
+func TestCodeMetricsDisabled(t *testing.T) {
+	m := newCodeMetrics(0)
+
+	require.Equal(t, metricsOtherCodes, m.label(1, 100))
+	require.Equal(t, metricsOtherCodes, m.label(1, 100))
+	require.Empty(t, m.tracked)
+}
+
+func TestExecuteRecordsCodeMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	conf := metrics.DefaultConfig("")
+	conf.EnableHostname = false
+	conf.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(conf, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(conf, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	counters := func() map[string]metrics.SampledValue {
+		data := sink.Data()
+		require.NotEmpty(t, data)
+		return data[len(data)-1].Counters
+	}
+	callsKey := fmt.Sprintf("compute.execute.calls;code_id=%d", codeID)
+	gasKey := fmt.Sprintf("compute.execute.gas_used;code_id=%d", codeID)
+
+	for i := 1; i <= 2; i++ {
+		_, _, _, _, _, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"empty_log_key_value":{}}`, true, true, defaultGasForTests, 0)
+		require.Empty(t, execErr)
+
+		require.Equal(t, i, counters()[callsKey].Count)
+		require.Equal(t, i, counters()[gasKey].Count)
+		require.Positive(t, counters()[gasKey].Sum)
+	}
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// ProposeContractMigration announces the migration of a contract to newCodeID, which can be
+// executed with MsgMigrateContract in any block after executeAfterHeight, which has to be at least
+// MinMigrationDelay blocks away. Only the contract admin can do this. The first proposal
+// time-locks the contract for good: from then on it can only be migrated to the code of a pending
+// migration whose challenge window is over, so users can observe every upcoming migration and exit
+// the contract beforehand.
+func (k Keeper) ProposeContractMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress, newCodeID uint64, executeAfterHeight int64) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if contractInfo.Admin == "" || contractInfo.Admin != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the admin")
+	}
+	if contractInfo.Terminated {
+		return sdkerrors.Wrap(types.ErrContractTerminated, contractAddress.String())
+	}
+	if _, err := k.GetCodeInfo(ctx, newCodeID); err != nil {
+		return sdkerrors.Wrap(types.ErrNotFound, err.Error())
+	}
+	if minHeight := ctx.BlockHeight() + types.MinMigrationDelay; executeAfterHeight < minHeight {
+		return sdkerrors.Wrapf(types.ErrInvalid, "execute after height %d is less than %d blocks after the current height %d", executeAfterHeight, types.MinMigrationDelay, ctx.BlockHeight())
+	}
+	if k.GetPendingMigration(ctx, contractAddress) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "contract already has a pending migration")
+	}
+
+	k.setPendingMigration(ctx, contractAddress, types.PendingMigration{
+		ContractAddress:    contractAddress.String(),
+		CodeID:             newCodeID,
+		ProposedAtHeight:   ctx.BlockHeight(),
+		ExecuteAfterHeight: executeAfterHeight,
+	})
+	if !contractInfo.MigrationTimelocked {
+		contractInfo.MigrationTimelocked = true
+		k.setContractInfo(ctx, contractAddress, contractInfo)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposeMigration,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyExecuteAfterHeight, strconv.FormatInt(executeAfterHeight, 10)),
+	))
+	return nil
+}
+
+// CancelContractMigration removes the pending migration of a contract. Only the contract admin
+// can do this. The contract stays time-locked.
+func (k Keeper) CancelContractMigration(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if contractInfo.Admin == "" || contractInfo.Admin != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the admin")
+	}
+	if k.GetPendingMigration(ctx, contractAddress) == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "pending migration")
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingMigrationKey(contractAddress))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelMigration,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+	return nil
+}
+
+// consumePendingMigration checks that a time-locked contract may be migrated to newCodeID in the
+// current block and removes its pending migration. Contracts that were never time-locked may be
+// migrated right away, like before.
+func (k Keeper) consumePendingMigration(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo types.ContractInfo, newCodeID uint64) error {
+	if !contractInfo.MigrationTimelocked {
+		return nil
+	}
+
+	pending := k.GetPendingMigration(ctx, contractAddress)
+	if pending == nil {
+		return sdkerrors.Wrap(types.ErrMigrationTimelocked, "no pending migration")
+	}
+	if pending.CodeID != newCodeID {
+		return sdkerrors.Wrapf(types.ErrMigrationTimelocked, "pending migration is to code %d", pending.CodeID)
+	}
+	if ctx.BlockHeight() <= pending.ExecuteAfterHeight {
+		return sdkerrors.Wrapf(types.ErrMigrationTimelocked, "challenge window ends at height %d", pending.ExecuteAfterHeight)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetPendingMigrationKey(contractAddress))
+	return nil
+}
+
+// GetPendingMigration returns the pending migration of a contract, or nil if there is none
+func (k Keeper) GetPendingMigration(ctx sdk.Context, contractAddress sdk.AccAddress) *types.PendingMigration {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingMigrationKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var pending types.PendingMigration
+	k.cdc.MustUnmarshal(bz, &pending)
+	return &pending
+}
+
+func (k Keeper) setPendingMigration(ctx sdk.Context, contractAddress sdk.AccAddress, pending types.PendingMigration) {
+	ctx.KVStore(k.storeKey).Set(types.GetPendingMigrationKey(contractAddress), k.cdc.MustMarshal(&pending))
+}
+
+// IteratePendingMigrations iterates over all pending migrations, ordered by contract address
+func (k Keeper) IteratePendingMigrations(ctx sdk.Context, cb func(types.PendingMigration) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingMigrationPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pending types.PendingMigration
+		k.cdc.MustUnmarshal(iter.Value(), &pending)
+		if cb(pending) {
+			break
+		}
+	}
+}
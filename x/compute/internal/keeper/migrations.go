@@ -134,6 +134,140 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate5to6 migrates from version 5 to 6. The migration rebuilds the label -> contract address index
+// from the stored contracts, so every contract can be looked up by its label.
+// Labels were always meant to be unique; if a label already points to another contract the collision
+// is logged and the existing index entry is kept.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	kvStore := ctx.KVStore(m.keeper.storeKey)
+	iter := prefix.NewStore(kvStore, types.ContractKeyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	formatter := message.NewPrinter(language.English)
+	migratedContracts := uint64(0)
+	totalContracts := m.keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) - 1
+	previousTime := time.Now().UnixNano()
+
+	for ; iter.Valid(); iter.Next() {
+		var contractAddress sdk.AccAddress = iter.Key()
+
+		var contractInfo types.ContractInfo
+		m.keeper.cdc.MustUnmarshal(iter.Value(), &contractInfo)
+
+		labelKey := types.GetContractLabelPrefix(contractInfo.Label)
+		var indexedAddress sdk.AccAddress = kvStore.Get(labelKey)
+		switch {
+		case indexedAddress == nil:
+			kvStore.Set(labelKey, contractAddress)
+		case !indexedAddress.Equals(contractAddress):
+			ctx.Logger().Error(
+				"duplicate contract label, keeping the existing index entry",
+				"label", contractInfo.Label,
+				"indexed_contract", indexedAddress.String(),
+				"contract", contractAddress.String(),
+			)
+		}
+
+		migratedContracts++
+		logMigrationProgress(ctx, formatter, migratedContracts, totalContracts, previousTime)
+		previousTime = time.Now().UnixNano()
+	}
+	return nil
+}
+
+// Migrate6to7 migrates from version 6 to 7. Codes stored before instantiate permissions existed
+// have an undefined instantiate config; they were open to everybody, so that is made explicit here.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.CodeKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var codeInfo types.CodeInfo
+		m.keeper.cdc.MustUnmarshal(iter.Value(), &codeInfo)
+
+		if codeInfo.InstantiateConfig.Permission != types.AccessTypeUndefined {
+			continue
+		}
+		codeInfo.InstantiateConfig = types.AllowEverybody
+		store.Set(iter.Key(), m.keeper.cdc.MustMarshal(&codeInfo))
+	}
+	return nil
+}
+
+// Migrate7to8 migrates from version 7 to 8. The migration stores the default compute params and
+// records the current storage usage of every contract, so the per-contract storage limit starts
+// from accurate numbers.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	m.keeper.setParams(ctx, types.DefaultParams())
+
+	iter := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.ContractKeyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	formatter := message.NewPrinter(language.English)
+	migratedContracts := uint64(0)
+	totalContracts := m.keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) - 1
+	previousTime := time.Now().UnixNano()
+
+	for ; iter.Valid(); iter.Next() {
+		var contractAddress sdk.AccAddress = iter.Key()
+		m.keeper.setContractStorageUsage(ctx, contractAddress, m.keeper.computeContractStorageUsage(ctx, contractAddress))
+
+		migratedContracts++
+		logMigrationProgress(ctx, formatter, migratedContracts, totalContracts, previousTime)
+		previousTime = time.Now().UnixNano()
+	}
+	return nil
+}
+
+// Migrate8to9 migrates from version 8 to 9. The migration counts the stored codes and contracts,
+// which are kept as counters from now on.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	for _, counter := range []struct {
+		key    []byte
+		prefix []byte
+	}{
+		{types.KeyCodesCount, types.CodeKeyPrefix},
+		{types.KeyContractsCount, types.ContractKeyPrefix},
+	} {
+		iter := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), counter.prefix).Iterator(nil, nil)
+		count := uint64(0)
+		for ; iter.Valid(); iter.Next() {
+			count++
+		}
+		iter.Close()
+		m.keeper.setCount(ctx, counter.key, count)
+	}
+	return nil
+}
+
+// Migrate9to10 migrates from version 9 to 10. The migration builds the creator -> contract
+// address index from the stored contracts, so the contracts of every creator can be listed.
+// Contracts from before creation positions were recorded are indexed at position zero, ahead of
+// the creator's newer contracts.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	iter := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.ContractKeyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	formatter := message.NewPrinter(language.English)
+	migratedContracts := uint64(0)
+	totalContracts := m.keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) - 1
+	previousTime := time.Now().UnixNano()
+
+	for ; iter.Valid(); iter.Next() {
+		var contractAddress sdk.AccAddress = iter.Key()
+
+		var contractInfo types.ContractInfo
+		m.keeper.cdc.MustUnmarshal(iter.Value(), &contractInfo)
+		m.keeper.addToContractCreatorSecondaryIndex(ctx, contractInfo.Creator, contractInfo.InitialHistory(nil).Updated, contractAddress)
+
+		migratedContracts++
+		logMigrationProgress(ctx, formatter, migratedContracts, totalContracts, previousTime)
+		previousTime = time.Now().UnixNano()
+	}
+	return nil
+}
+
 const progressPartSize = 1000
 
 func logMigrationProgress(ctx sdk.Context, formatter *message.Printer, migratedContracts uint64, totalContracts uint64, previousTime int64) {
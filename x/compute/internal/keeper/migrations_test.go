@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestMigrate5to6RebuildsLabelIndex(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	unindexed := contractAddress(1, 1, nil)
+	indexed := contractAddress(1, 2, nil)
+	duplicate := contractAddress(1, 3, nil)
+
+	keeper.setContractInfo(ctx, unindexed, &types.ContractInfo{CodeID: 1, Label: "unindexed"})
+	keeper.setContractInfo(ctx, indexed, &types.ContractInfo{CodeID: 1, Label: "taken"})
+	keeper.setContractInfo(ctx, duplicate, &types.ContractInfo{CodeID: 1, Label: "taken"})
+	ctx.KVStore(keeper.storeKey).Set(types.GetContractLabelPrefix("taken"), indexed)
+
+	require.Nil(t, keeper.GetContractAddress(ctx, "unindexed"))
+
+	err := NewMigrator(keeper).Migrate5to6(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, unindexed, keeper.GetContractAddress(ctx, "unindexed"))
+	// a collision keeps the existing entry instead of failing the migration
+	require.Equal(t, indexed, keeper.GetContractAddress(ctx, "taken"))
+}
+
+func TestMigrate6to7SetsLegacyInstantiatePermission(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	store := ctx.KVStore(keeper.storeKey)
+
+	onlyAddress := types.OnlyAddressAccess(contractAddress(1, 1, nil))
+	legacyCode := types.CodeInfoFixture(func(c *types.CodeInfo) { c.InstantiateConfig = types.AccessConfig{} })
+	restrictedCode := types.CodeInfoFixture(func(c *types.CodeInfo) { c.InstantiateConfig = onlyAddress })
+	store.Set(types.GetCodeKey(1), keeper.cdc.MustMarshal(&legacyCode))
+	store.Set(types.GetCodeKey(2), keeper.cdc.MustMarshal(&restrictedCode))
+
+	err := NewMigrator(keeper).Migrate6to7(ctx)
+	require.NoError(t, err)
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.AllowEverybody, codeInfo.InstantiateConfig)
+
+	codeInfo, err = keeper.GetCodeInfo(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, onlyAddress, codeInfo.InstantiateConfig)
+}
+
+func TestMigrate7to8RecordsContractStorageUsage(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	store := ctx.KVStore(keeper.storeKey)
+
+	addr := contractAddress(1, 1, nil)
+	contractInfo := types.ContractInfoFixture()
+	store.Set(types.GetContractAddressKey(addr), keeper.cdc.MustMarshal(&contractInfo))
+	contractStore := prefix.NewStore(store, types.GetContractStorePrefixKey(addr))
+	contractStore.Set([]byte("foo"), []byte("bar"))
+	contractStore.Set([]byte("key"), []byte("value"))
+
+	err := NewMigrator(keeper).Migrate7to8(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(14), keeper.GetContractStorageUsage(ctx, addr))
+	require.Equal(t, types.DefaultParams(), keeper.GetParams(ctx))
+}
+
+func TestMigrate8to9CountsCodesAndContracts(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+	store := ctx.KVStore(keeper.storeKey)
+
+	codeInfo := types.CodeInfoFixture()
+	store.Set(types.GetCodeKey(1), keeper.cdc.MustMarshal(&codeInfo))
+	for i := uint64(1); i <= 3; i++ {
+		keeper.setContractInfo(ctx, contractAddress(1, i, nil), &types.ContractInfo{CodeID: 1})
+	}
+
+	err := NewMigrator(keeper).Migrate8to9(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(1), keeper.GetCodesCount(ctx))
+	require.Equal(t, uint64(3), keeper.GetContractsCount(ctx))
+}
+
+func TestMigrate9to10IndexesContractsByCreator(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	creator := contractAddress(2, 1, nil)
+	legacy := contractAddress(1, 1, nil)
+	newer := contractAddress(1, 2, nil)
+	keeper.setContractInfo(ctx, newer, &types.ContractInfo{CodeID: 1, Creator: creator, Created: &types.AbsoluteTxPosition{BlockHeight: 5}})
+	// contracts from before creation positions were recorded have none
+	keeper.setContractInfo(ctx, legacy, &types.ContractInfo{CodeID: 1, Creator: creator})
+
+	err := NewMigrator(keeper).Migrate9to10(ctx)
+	require.NoError(t, err)
+
+	var contracts []sdk.AccAddress
+	keeper.IterateContractsByCreator(ctx, creator, func(contractAddress sdk.AccAddress) bool {
+		contracts = append(contracts, contractAddress)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{legacy, newer}, contracts)
+}
@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
 
@@ -31,7 +30,10 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
 	))
 
-	codeID, err := m.keeper.Create(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder)
+	codeID, err := m.keeper.CreateWithOptions(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder, CreateOptions{
+		InstantiatePermission: msg.InstantiatePermission,
+		SourceChecksum:        msg.SourceChecksum,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -59,7 +61,16 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 		}
 	}
 
-	contractAddr, data, err := m.keeper.Instantiate(ctx, msg.CodeID, msg.Sender, adminAddr, msg.InitMsg, msg.Label, msg.InitFunds, msg.CallbackSig)
+	var contractAddr sdk.AccAddress
+	var data []byte
+	err = m.keeper.MeterBlockComputeGas(ctx, func() (err error) {
+		contractAddr, data, err = m.keeper.InstantiateWithOptions(ctx, msg.CodeID, msg.Sender, adminAddr, msg.InitMsg, msg.Label, msg.InitFunds, msg.CallbackSig, InstantiateOptions{
+			Salt:                msg.Salt,
+			ReentrancyProtected: msg.ReentrancyProtected,
+			SendWhitelist:       msg.SendWhitelist,
+		})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +100,15 @@ func (m msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteC
 		sdk.NewAttribute(types.AttributeKeyContractAddr, msg.Contract.String()),
 	))
 
-	data, err := m.keeper.Execute(ctx, msg.Contract, msg.Sender, msg.Msg, msg.SentFunds, msg.CallbackSig, wasmtypes.HandleTypeExecute)
+	var data *sdk.Result
+	err := m.keeper.MeterBlockComputeGas(ctx, func() (err error) {
+		if msg.ReadOnly {
+			data, err = m.keeper.ExecuteReadOnly(ctx, msg.Contract, msg.Sender, msg.Msg, msg.CallbackSig)
+		} else {
+			data, err = m.keeper.ExecuteCorrelated(ctx, msg.Contract, msg.Sender, msg.Msg, msg.SentFunds, msg.CallbackSig, msg.CorrelationID)
+		}
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -189,3 +208,143 @@ func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (
 
 	return &types.MsgClearAdminResponse{}, nil
 }
+
+func (m msgServer) PauseContract(goCtx context.Context, msg *types.MsgPauseContract) (*types.MsgPauseContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.SetContractPaused(ctx, contractAddr, senderAddr, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseContractResponse{}, nil
+}
+
+func (m msgServer) ResumeContract(goCtx context.Context, msg *types.MsgResumeContract) (*types.MsgResumeContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.SetContractPaused(ctx, contractAddr, senderAddr, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeContractResponse{}, nil
+}
+
+func (m msgServer) TerminateContract(goCtx context.Context, msg *types.MsgTerminateContract) (*types.MsgTerminateContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "recipient")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Contract),
+	))
+
+	if err := m.keeper.TerminateContract(ctx, contractAddr, recipientAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTerminateContractResponse{}, nil
+}
+
+func (m msgServer) ProposeContractMigration(goCtx context.Context, msg *types.MsgProposeContractMigration) (*types.MsgProposeContractMigrationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.ProposeContractMigration(ctx, contractAddr, senderAddr, msg.CodeID, msg.ExecuteAfterHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeContractMigrationResponse{}, nil
+}
+
+func (m msgServer) CancelContractMigration(goCtx context.Context, msg *types.MsgCancelContractMigration) (*types.MsgCancelContractMigrationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.CancelContractMigration(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelContractMigrationResponse{}, nil
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// GetContractPortfolio returns the bank balance of a contract together with the bond denom
+// tokens it delegated and the tokens of its unbonding delegations, which a bank balance query
+// doesn't show. A contract that never delegated gets zero coins for both.
+func (k Keeper) GetContractPortfolio(ctx sdk.Context, contractAddress sdk.AccAddress) types.QueryContractPortfolioResponse {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	return types.QueryContractPortfolioResponse{
+		Balance:   k.bankKeeper.GetAllBalances(ctx, contractAddress),
+		Delegated: sdk.NewCoin(bondDenom, k.stakingKeeper.GetDelegatorBonded(ctx, contractAddress)),
+		Unbonding: sdk.NewCoin(bondDenom, k.stakingKeeper.GetDelegatorUnbonding(ctx, contractAddress)),
+	}
+}
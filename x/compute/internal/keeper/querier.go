@@ -1,19 +1,26 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"sort"
 
 	"github.com/golang/protobuf/ptypes/empty"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
 
 var _ types.QueryServer = GrpcQuerier{} // type assertion
 
+// contractStateRangeMaxLimit is the largest page of entries ContractStateRange returns
+const contractStateRangeMaxLimit = 1000
+
 type GrpcQuerier struct {
 	keeper Keeper
 }
@@ -24,8 +31,355 @@ func (q GrpcQuerier) ContractHistory(c context.Context, req *types.QueryContract
 		return nil, err
 	}
 
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entries := make([]types.ContractCodeHistoryEntry, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractCodeHistoryElementPrefix(contractAddress))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var e types.ContractCodeHistoryEntry
+		if err := q.keeper.cdc.Unmarshal(value, &e); err != nil {
+			return err
+		}
+		entries = append(entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryContractHistoryResponse{
-		Entries: q.keeper.GetContractHistory(sdk.UnwrapSDKContext(c), contractAddress),
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractState(c context.Context, req *types.QueryContractStateRequest) (*types.QueryContractStateResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.containsContractInfo(ctx, contractAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
+	}
+
+	models := make([]types.Model, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractStorePrefixKey(contractAddress))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		models = append(models, types.Model{
+			Key:   key,
+			Value: value,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractStateResponse{
+		Models:     models,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractStateRange(c context.Context, req *types.QueryContractStateRangeRequest) (*types.QueryContractStateResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Start) > 0 && len(req.End) > 0 && bytes.Compare(req.Start, req.End) >= 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "start must be before end")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 || pageReq.CountTotal {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "only key based pagination is supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	if limit > contractStateRangeMaxLimit {
+		limit = contractStateRangeMaxLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.containsContractInfo(ctx, contractAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
+	}
+
+	start, end := contractStateRange(req.Prefix, req.Start, req.End)
+	// the page key is the first key of the next page in iteration order
+	if len(pageReq.Key) > 0 {
+		if pageReq.Reverse {
+			end = rangeEnd(end, append(append([]byte{}, pageReq.Key...), 0))
+		} else {
+			start = rangeStart(start, pageReq.Key)
+		}
+	}
+
+	models := make([]types.Model, 0)
+	pageRes := &query.PageResponse{}
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return &types.QueryContractStateResponse{Models: models, Pagination: pageRes}, nil
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractStorePrefixKey(contractAddress))
+	var iter sdk.Iterator
+	if pageReq.Reverse {
+		iter = prefixStore.ReverseIterator(start, end)
+	} else {
+		iter = prefixStore.Iterator(start, end)
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(models)) == limit {
+			pageRes.NextKey = iter.Key()
+			break
+		}
+		models = append(models, types.Model{
+			Key:   iter.Key(),
+			Value: iter.Value(),
+		})
+	}
+
+	return &types.QueryContractStateResponse{
+		Models:     models,
+		Pagination: pageRes,
+	}, nil
+}
+
+// contractStateRange returns the iterator bounds of the keys that start with keyPrefix and fall
+// within [start, end). A nil bound is unbounded.
+func contractStateRange(keyPrefix, start, end []byte) ([]byte, []byte) {
+	var lower, upper []byte
+	if len(keyPrefix) > 0 {
+		lower = keyPrefix
+		upper = sdk.PrefixEndBytes(keyPrefix)
+	}
+	if len(start) > 0 {
+		lower = rangeStart(lower, start)
+	}
+	if len(end) > 0 {
+		upper = rangeEnd(upper, end)
+	}
+	return lower, upper
+}
+
+// rangeStart returns the later of two inclusive lower bounds
+func rangeStart(a, b []byte) []byte {
+	if a == nil || bytes.Compare(b, a) > 0 {
+		return b
+	}
+	return a
+}
+
+// rangeEnd returns the earlier of two exclusive upper bounds
+func rangeEnd(a, b []byte) []byte {
+	if a == nil || bytes.Compare(b, a) < 0 {
+		return b
+	}
+	return a
+}
+
+func (q GrpcQuerier) ContractStateByKey(c context.Context, req *types.QueryContractStateByKeyRequest) (*types.QueryContractStateByKeyResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.keeper.queryGasLimit))
+
+	value, err := q.keeper.GetContractStateByKey(ctx, contractAddress, req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	contractKey, err := q.keeper.GetContractKey(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractStateByKeyResponse{
+		Value:         value,
+		StorePrefix:   types.GetContractStorePrefixKey(contractAddress),
+		OgContractKey: contractKey.OgContractKey,
+	}, nil
+}
+
+func (q GrpcQuerier) PredictAddress(_ context.Context, req *types.QueryPredictAddressRequest) (*types.QueryContractAddressResponse, error) {
+	if req.CodeId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
+	}
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "creator")
+	}
+	if len(req.Salt) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "salt")
+	}
+	if len(req.Salt) > types.MaxSaltSize {
+		return nil, sdkerrors.Wrapf(types.ErrLimit, "salt cannot be longer than %d bytes", types.MaxSaltSize)
+	}
+
+	return &types.QueryContractAddressResponse{
+		ContractAddress: PredictContractAddress(req.CodeId, creator, req.Salt).String(),
+	}, nil
+}
+
+func (q GrpcQuerier) ContractStorageUsage(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractStorageUsageResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !q.keeper.containsContractInfo(ctx, contractAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
+	}
+
+	return &types.QueryContractStorageUsageResponse{
+		UsedBytes: q.keeper.GetContractStorageUsage(ctx, contractAddress),
+		MaxBytes:  q.keeper.GetParams(ctx).MaxContractStorageBytes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractIbcPortId(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractIbcPortIdResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractInfo := q.keeper.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
+	}
+
+	return &types.QueryContractIbcPortIdResponse{PortId: contractInfo.IBCPortID}, nil
+}
+
+func (q GrpcQuerier) ContractPortfolio(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractPortfolioResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if q.keeper.GetContractInfo(ctx, contractAddress) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
+	}
+
+	portfolio := q.keeper.GetContractPortfolio(ctx, contractAddress)
+	return &portfolio, nil
+}
+
+func (q GrpcQuerier) ContractIbcChannels(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractIbcChannelsResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractInfo := q.keeper.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
+	}
+
+	channels := make([]channeltypes.IdentifiedChannel, 0)
+	if contractInfo.IBCPortID != "" {
+		q.keeper.channelKeeper.IterateChannels(ctx, func(ch channeltypes.IdentifiedChannel) bool {
+			if ch.PortId == contractInfo.IBCPortID && ch.State == channeltypes.OPEN {
+				channels = append(channels, ch)
+			}
+			return false
+		})
+	}
+
+	return &types.QueryContractIbcChannelsResponse{Channels: channels}, nil
+}
+
+func (q GrpcQuerier) ContractEventsByAttribute(c context.Context, req *types.QueryContractEventsByAttributeRequest) (*types.QueryContractEventsByAttributeResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	if req.Key == "" {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "key")
+	}
+	if len(req.Key) > types.MaxIndexedEventAttributeKeySize {
+		return nil, sdkerrors.Wrapf(types.ErrLimit, "key cannot be longer than %d bytes", types.MaxIndexedEventAttributeKeySize)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	attributes := make([]types.IndexedEventAttribute, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetIndexedEventAttributePrefix(contractAddress, req.Key))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var attr types.IndexedEventAttribute
+		if err := q.keeper.cdc.Unmarshal(value, &attr); err != nil {
+			return false, err
+		}
+		if req.Value != "" && attr.Value != req.Value {
+			return false, nil
+		}
+		if accumulate {
+			attributes = append(attributes, attr)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractEventsByAttributeResponse{
+		Attributes: attributes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ResultByCorrelationId(c context.Context, req *types.QueryResultByCorrelationIdRequest) (*types.QueryResultByCorrelationIdResponse, error) {
+	senderAddress, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if req.CorrelationId == "" {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "correlation id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	result := q.keeper.GetExecutionResultByCorrelationID(ctx, senderAddress, req.CorrelationId)
+	if result == nil {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "result of %s with correlation id %q", req.SenderAddress, req.CorrelationId)
+	}
+
+	return &types.QueryResultByCorrelationIdResponse{Result: *result}, nil
+}
+
+func (q GrpcQuerier) PendingMigrations(c context.Context, req *types.QueryPendingMigrationsRequest) (*types.QueryPendingMigrationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingMigrations := make([]types.PendingMigration, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.PendingMigrationPrefix)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_ []byte, value []byte) error {
+		var pending types.PendingMigration
+		if err := q.keeper.cdc.Unmarshal(value, &pending); err != nil {
+			return err
+		}
+		pendingMigrations = append(pendingMigrations, pending)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingMigrationsResponse{
+		PendingMigrations: pendingMigrations,
+		Pagination:        pageRes,
 	}, nil
 }
 
@@ -44,7 +398,7 @@ func (q GrpcQuerier) ContractInfo(c context.Context, req *types.QueryByContractA
 	case err != nil:
 		return nil, err
 	case response == nil:
-		return nil, types.ErrNotFound
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
 	}
 
 	return &types.QueryContractInfoResponse{
@@ -53,21 +407,60 @@ func (q GrpcQuerier) ContractInfo(c context.Context, req *types.QueryByContractA
 	}, nil
 }
 
-func (q GrpcQuerier) ContractsByCodeId(c context.Context, req *types.QueryByCodeIdRequest) (*types.QueryContractsByCodeIdResponse, error) {
+func (q GrpcQuerier) ContractsByCodeId(c context.Context, req *types.QueryContractsByCodeIdRequest) (*types.QueryContractsByCodeIdResponse, error) {
 	if req.CodeId == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
 	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contracts := make([]types.ContractInfoWithAddress, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(req.CodeId))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, _ []byte) error {
+		var contractAddress sdk.AccAddress = key[types.AbsoluteTxPositionLen:]
+		info := q.keeper.GetContractInfo(ctx, contractAddress)
+		if info == nil {
+			return sdkerrors.Wrapf(types.ErrNotFound, "contract %s", contractAddress)
+		}
+		info.AdminProof = nil // for internal usage only
+		info.Created = nil
 
-	response, err := queryContractListByCode(sdk.UnwrapSDKContext(c), req.CodeId, q.keeper)
-	switch {
-	case err != nil:
+		contracts = append(contracts, types.ContractInfoWithAddress{
+			ContractAddress: contractAddress.String(),
+			ContractInfo:    info,
+		})
+		return nil
+	})
+	if err != nil {
 		return nil, err
-	case response == nil:
-		return nil, types.ErrNotFound
 	}
 
 	return &types.QueryContractsByCodeIdResponse{
-		ContractInfos: response,
+		ContractInfos: contracts,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContractsByCreatorRequest) (*types.QueryContractsByCreatorResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddresses := make([]string, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractsByCreatorPrefix(creator))
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, _ []byte) error {
+		var contractAddress sdk.AccAddress = key[types.AbsoluteTxPositionLen:]
+		contractAddresses = append(contractAddresses, contractAddress.String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByCreatorResponse{
+		ContractAddresses: contractAddresses,
+		Pagination:        pageRes,
 	}, nil
 }
 
@@ -109,6 +502,29 @@ func (q GrpcQuerier) Code(c context.Context, req *types.QueryByCodeIdRequest) (*
 	}, nil
 }
 
+func (q GrpcQuerier) VerifyCodeSource(c context.Context, req *types.QueryByCodeIdRequest) (*types.QueryCodeSourceResponse, error) {
+	if req.CodeId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.containsCodeInfo(ctx, req.CodeId) {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "code %d", req.CodeId)
+	}
+	codeInfo, err := q.keeper.GetCodeInfo(ctx, req.CodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCodeSourceResponse{
+		CodeId:         req.CodeId,
+		CodeHash:       hex.EncodeToString(codeInfo.CodeHash),
+		Source:         codeInfo.Source,
+		Builder:        codeInfo.Builder,
+		SourceChecksum: hex.EncodeToString(codeInfo.SourceChecksum),
+	}, nil
+}
+
 func (q GrpcQuerier) Codes(c context.Context, _ *empty.Empty) (*types.QueryCodesResponse, error) {
 	response, err := queryCodeList(sdk.UnwrapSDKContext(c), q.keeper)
 	switch {
@@ -121,6 +537,14 @@ func (q GrpcQuerier) Codes(c context.Context, _ *empty.Empty) (*types.QueryCodes
 	return &types.QueryCodesResponse{CodeInfos: response}, nil
 }
 
+func (q GrpcQuerier) CodesCount(c context.Context, _ *empty.Empty) (*types.QueryCountResponse, error) {
+	return &types.QueryCountResponse{Count: q.keeper.GetCodesCount(sdk.UnwrapSDKContext(c))}, nil
+}
+
+func (q GrpcQuerier) ContractsCount(c context.Context, _ *empty.Empty) (*types.QueryCountResponse, error) {
+	return &types.QueryCountResponse{Count: q.keeper.GetContractsCount(sdk.UnwrapSDKContext(c))}, nil
+}
+
 func (q GrpcQuerier) CodeHashByContractAddress(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryCodeHashResponse, error) {
 	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
 	if err != nil {
@@ -134,7 +558,7 @@ func (q GrpcQuerier) CodeHashByContractAddress(c context.Context, req *types.Que
 	case err != nil:
 		return nil, err
 	case codeHashBz == nil:
-		return nil, types.ErrNotFound
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s", req.ContractAddress)
 	}
 
 	return &types.QueryCodeHashResponse{
@@ -145,12 +569,16 @@ func (q GrpcQuerier) CodeHashByContractAddress(c context.Context, req *types.Que
 func (q GrpcQuerier) CodeHashByCodeId(c context.Context, req *types.QueryByCodeIdRequest) (*types.QueryCodeHashResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.keeper.queryGasLimit))
 
+	if !q.keeper.containsCodeInfo(ctx, req.CodeId) {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "code id %d", req.CodeId)
+	}
+
 	codeHashBz, err := queryCodeHashByCodeID(ctx, req.CodeId, q.keeper)
 	switch {
 	case err != nil:
 		return nil, err
 	case codeHashBz == nil:
-		return nil, types.ErrNotFound
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "code id %d", req.CodeId)
 	}
 
 	return &types.QueryCodeHashResponse{
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -12,8 +13,10 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
@@ -370,4 +373,383 @@ func TestListContractByCodeOrdering(t *testing.T) {
 		// assert.Nil(t, contract.InitMsg)
 		assert.Nil(t, contract.Created)
 	}
+
+	// the grpc query walks the code id index and pages through the same ordering
+	grpcQuerier := GrpcQuerier{keeper: keeper}
+	var pageKey []byte
+	var paged []types.ContractInfoWithAddress
+	for {
+		rsp, err := grpcQuerier.ContractsByCodeId(sdk.WrapSDKContext(ctx), &types.QueryContractsByCodeIdRequest{
+			CodeId:     codeID,
+			Pagination: &sdkquery.PageRequest{Key: pageKey, Limit: 4},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(rsp.ContractInfos), 4)
+		paged = append(paged, rsp.ContractInfos...)
+
+		pageKey = rsp.Pagination.NextKey
+		if pageKey == nil {
+			break
+		}
+	}
+	require.Equal(t, contracts, paged)
+}
+
+func TestQueryCodeHash(t *testing.T) {
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	byAddress, err := grpcQuerier.CodeHashByContractAddress(goCtx, &types.QueryByContractAddressRequest{ContractAddress: contractAddress.String()})
+	require.NoError(t, err)
+	require.Equal(t, codeHash, byAddress.CodeHash)
+
+	byCodeID, err := grpcQuerier.CodeHashByCodeId(goCtx, &types.QueryByCodeIdRequest{CodeId: codeID})
+	require.NoError(t, err)
+	require.Equal(t, codeHash, byCodeID.CodeHash)
+
+	_, _, unknownAddress := keyPubAddr()
+	_, err = grpcQuerier.CodeHashByContractAddress(goCtx, &types.QueryByContractAddressRequest{ContractAddress: unknownAddress.String()})
+	require.True(t, types.ErrNotFound.Is(err), err)
+
+	_, err = grpcQuerier.CodeHashByCodeId(goCtx, &types.QueryByCodeIdRequest{CodeId: codeID + 1})
+	require.True(t, types.ErrNotFound.Is(err), err)
+}
+
+func TestQueryContractInfo(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	rsp, err := grpcQuerier.ContractInfo(goCtx, &types.QueryByContractAddressRequest{ContractAddress: contractAddress.String()})
+	require.NoError(t, err)
+	require.Equal(t, contractAddress.String(), rsp.ContractAddress)
+	require.Equal(t, codeID, rsp.ContractInfo.CodeID)
+	require.Equal(t, walletA, rsp.ContractInfo.Creator)
+	require.Equal(t, ctx.BlockHeight(), rsp.ContractInfo.Created.BlockHeight)
+	require.NotEmpty(t, rsp.ContractInfo.Label)
+	// the admin proof is for internal usage only
+	require.Empty(t, rsp.ContractInfo.AdminProof)
+
+	_, _, unknownAddress := keyPubAddr()
+	_, err = grpcQuerier.ContractInfo(goCtx, &types.QueryByContractAddressRequest{ContractAddress: unknownAddress.String()})
+	require.True(t, types.ErrNotFound.Is(err), err)
+}
+
+func TestVerifyCodeSource(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, keeper := keepers.AccountKeeper, keepers.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator, _ := CreateFakeFundedAccount(ctx, accKeeper, keeper.bankKeeper, deposit)
+
+	wasmCode, err := os.ReadFile(TestContractPaths[hackAtomContract])
+	require.NoError(t, err)
+
+	source := "https://github.com/scrtlabs/SecretNetwork/archive/v1.0.0.tar.gz"
+	sourceChecksum := sha256.Sum256([]byte("source archive"))
+	codeID, err := keeper.CreateWithOptions(ctx, creator, wasmCode, source, "enigmampc/secret-contract-optimizer:1.0.5", CreateOptions{SourceChecksum: sourceChecksum[:]})
+	require.NoError(t, err)
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	rsp, err := grpcQuerier.VerifyCodeSource(goCtx, &types.QueryByCodeIdRequest{CodeId: codeID})
+	require.NoError(t, err)
+	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCodeSourceResponse{
+		CodeId:         codeID,
+		CodeHash:       hex.EncodeToString(codeInfo.CodeHash),
+		Source:         source,
+		Builder:        "enigmampc/secret-contract-optimizer:1.0.5",
+		SourceChecksum: hex.EncodeToString(sourceChecksum[:]),
+	}, rsp)
+
+	_, err = grpcQuerier.VerifyCodeSource(goCtx, &types.QueryByCodeIdRequest{CodeId: codeID + 1})
+	require.True(t, types.ErrNotFound.Is(err), err)
+
+	_, err = grpcQuerier.VerifyCodeSource(goCtx, &types.QueryByCodeIdRequest{})
+	require.True(t, types.ErrInvalid.Is(err), err)
+}
+
+func TestQueryContractStateByKey(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	storeKey := []byte("some-encrypted-key")
+	storeValue := []byte("some-encrypted-value")
+	contractStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), types.GetContractStorePrefixKey(contractAddress))
+	contractStore.Set(storeKey, storeValue)
+
+	contractKey, err := keeper.GetContractKey(ctx, contractAddress)
+	require.NoError(t, err)
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	rsp, err := grpcQuerier.ContractStateByKey(goCtx, &types.QueryContractStateByKeyRequest{ContractAddress: contractAddress.String(), Key: storeKey})
+	require.NoError(t, err)
+	require.Equal(t, storeValue, rsp.Value)
+	require.Equal(t, types.GetContractStorePrefixKey(contractAddress), rsp.StorePrefix)
+	require.Equal(t, contractKey.OgContractKey, rsp.OgContractKey)
+
+	rsp, err = grpcQuerier.ContractStateByKey(goCtx, &types.QueryContractStateByKeyRequest{ContractAddress: contractAddress.String(), Key: []byte("unknown-key")})
+	require.NoError(t, err)
+	require.Empty(t, rsp.Value)
+
+	_, _, unknownAddress := keyPubAddr()
+	_, err = grpcQuerier.ContractStateByKey(goCtx, &types.QueryContractStateByKeyRequest{ContractAddress: unknownAddress.String(), Key: storeKey})
+	require.True(t, types.ErrNotFound.Is(err), err)
+
+	_, err = grpcQuerier.ContractStateByKey(goCtx, &types.QueryContractStateByKeyRequest{ContractAddress: contractAddress.String()})
+	require.True(t, types.ErrEmpty.Is(err), err)
+}
+
+func TestQueryContractHistoryPagination(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	contractAddr := contractAddress(1, 1, nil)
+	history := []types.ContractCodeHistoryEntry{
+		{Operation: types.ContractCodeHistoryOperationTypeInit, CodeID: 1, Updated: &types.AbsoluteTxPosition{BlockHeight: 1}, Msg: []byte("encrypted init")},
+		{Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 2, Updated: &types.AbsoluteTxPosition{BlockHeight: 2}, Msg: []byte("encrypted migrate")},
+		{Operation: types.ContractCodeHistoryOperationTypeMigrate, CodeID: 3, Updated: &types.AbsoluteTxPosition{BlockHeight: 3}, Msg: []byte("encrypted migrate")},
+	}
+	keeper.appendToContractHistory(ctx, contractAddr, history...)
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	var (
+		entries []types.ContractCodeHistoryEntry
+		nextKey []byte
+	)
+	for {
+		rsp, err := grpcQuerier.ContractHistory(sdk.WrapSDKContext(ctx), &types.QueryContractHistoryRequest{
+			ContractAddress: contractAddr.String(),
+			Pagination:      &sdkquery.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(rsp.Entries), 2)
+		entries = append(entries, rsp.Entries...)
+
+		nextKey = rsp.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, history, entries)
+}
+
+func TestQueryContractStatePagination(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	contractAddr := contractAddress(1, 1, nil)
+	contractInfo := types.ContractInfoFixture()
+	ctx.KVStore(keeper.storeKey).Set(types.GetContractAddressKey(contractAddr), keeper.cdc.MustMarshal(&contractInfo))
+
+	state := []types.Model{
+		{Key: []byte{0x0, 0x1}, Value: []byte("encrypted count")},
+		{Key: []byte("config"), Value: []byte("encrypted config")},
+		{Key: []byte("foo"), Value: []byte("encrypted bar")},
+	}
+	// imported out of order, returned in ascending key order
+	require.NoError(t, keeper.importContractState(ctx, contractAddr, []types.Model{state[2], state[0], state[1]}))
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	var (
+		models  []types.Model
+		nextKey []byte
+	)
+	for {
+		rsp, err := grpcQuerier.ContractState(sdk.WrapSDKContext(ctx), &types.QueryContractStateRequest{
+			ContractAddress: contractAddr.String(),
+			Pagination:      &sdkquery.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(rsp.Models), 2)
+		models = append(models, rsp.Models...)
+
+		nextKey = rsp.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, state, models)
+
+	_, _, unknownAddress := keyPubAddr()
+	_, err := grpcQuerier.ContractState(sdk.WrapSDKContext(ctx), &types.QueryContractStateRequest{ContractAddress: unknownAddress.String()})
+	require.True(t, types.ErrNotFound.Is(err), err)
+}
+
+func TestQueryContractStateRange(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	contractAddr := contractAddress(1, 1, nil)
+	contractInfo := types.ContractInfoFixture()
+	ctx.KVStore(keeper.storeKey).Set(types.GetContractAddressKey(contractAddr), keeper.cdc.MustMarshal(&contractInfo))
+
+	state := []types.Model{
+		{Key: []byte("balance/alice"), Value: []byte("encrypted 1")},
+		{Key: []byte("balance/bob"), Value: []byte("encrypted 2")},
+		{Key: []byte("balance/carol"), Value: []byte("encrypted 3")},
+		{Key: []byte("balance/dave"), Value: []byte("encrypted 4")},
+		{Key: []byte("config"), Value: []byte("encrypted config")},
+	}
+	require.NoError(t, keeper.importContractState(ctx, contractAddr, state))
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	queryAll := func(req types.QueryContractStateRangeRequest, reverse bool) []types.Model {
+		var (
+			models  []types.Model
+			nextKey []byte
+		)
+		for {
+			req.ContractAddress = contractAddr.String()
+			req.Pagination = &sdkquery.PageRequest{Key: nextKey, Limit: 1, Reverse: reverse}
+			rsp, err := grpcQuerier.ContractStateRange(sdk.WrapSDKContext(ctx), &req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(rsp.Models), 1)
+			models = append(models, rsp.Models...)
+
+			nextKey = rsp.Pagination.NextKey
+			if nextKey == nil {
+				return models
+			}
+		}
+	}
+
+	specs := map[string]struct {
+		req types.QueryContractStateRangeRequest
+		exp []types.Model
+	}{
+		"all": {
+			exp: state,
+		},
+		"prefix": {
+			req: types.QueryContractStateRangeRequest{Prefix: []byte("balance/")},
+			exp: state[:4],
+		},
+		"prefix with bounds": {
+			req: types.QueryContractStateRangeRequest{Prefix: []byte("balance/"), Start: []byte("balance/b"), End: []byte("balance/d")},
+			exp: state[1:3],
+		},
+		"start only": {
+			req: types.QueryContractStateRangeRequest{Start: []byte("balance/carol")},
+			exp: state[2:],
+		},
+		"end only": {
+			req: types.QueryContractStateRangeRequest{End: []byte("balance/carol")},
+			exp: state[:2],
+		},
+		"bounds outside prefix": {
+			req: types.QueryContractStateRangeRequest{Prefix: []byte("balance/"), Start: []byte("c")},
+			exp: nil,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, spec.exp, queryAll(spec.req, false))
+
+			var reversed []types.Model
+			for i := len(spec.exp) - 1; i >= 0; i-- {
+				reversed = append(reversed, spec.exp[i])
+			}
+			require.Equal(t, reversed, queryAll(spec.req, true))
+		})
+	}
+
+	t.Run("limit is capped", func(t *testing.T) {
+		bigContractAddr := contractAddress(1, 2, nil)
+		ctx.KVStore(keeper.storeKey).Set(types.GetContractAddressKey(bigContractAddr), keeper.cdc.MustMarshal(&contractInfo))
+		bigState := make([]types.Model, contractStateRangeMaxLimit+1)
+		for i := range bigState {
+			bigState[i] = types.Model{Key: sdk.Uint64ToBigEndian(uint64(i)), Value: []byte("encrypted")}
+		}
+		require.NoError(t, keeper.importContractState(ctx, bigContractAddr, bigState))
+
+		rsp, err := grpcQuerier.ContractStateRange(sdk.WrapSDKContext(ctx), &types.QueryContractStateRangeRequest{
+			ContractAddress: bigContractAddr.String(),
+			Pagination:      &sdkquery.PageRequest{Limit: contractStateRangeMaxLimit + 1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, bigState[:contractStateRangeMaxLimit], rsp.Models)
+		require.Equal(t, bigState[contractStateRangeMaxLimit].Key, rsp.Pagination.NextKey)
+	})
+
+	t.Run("invalid bounds", func(t *testing.T) {
+		_, err := grpcQuerier.ContractStateRange(sdk.WrapSDKContext(ctx), &types.QueryContractStateRangeRequest{
+			ContractAddress: contractAddr.String(),
+			Start:           []byte("b"),
+			End:             []byte("a"),
+		})
+		require.True(t, types.ErrInvalid.Is(err), err)
+	})
+
+	t.Run("offset pagination", func(t *testing.T) {
+		_, err := grpcQuerier.ContractStateRange(sdk.WrapSDKContext(ctx), &types.QueryContractStateRangeRequest{
+			ContractAddress: contractAddr.String(),
+			Pagination:      &sdkquery.PageRequest{Offset: 1},
+		})
+		require.True(t, types.ErrInvalid.Is(err), err)
+	})
+}
+
+func TestQueryContractsByCreator(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	var expected []string
+	for i := int64(1); i <= 3; i++ {
+		// instantiate in later and later blocks, which is the order the index keeps
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		_, _, contractAddr, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+		require.Empty(t, initErr)
+		expected = append(expected, contractAddr.String())
+	}
+	_, _, otherContract, _, initErr := initHelper(t, keeper, ctx, codeID, walletB, nil, privKeyB, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	var (
+		contracts []string
+		nextKey   []byte
+	)
+	for {
+		rsp, err := grpcQuerier.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{
+			CreatorAddress: walletA.String(),
+			Pagination:     &sdkquery.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(rsp.ContractAddresses), 2)
+		contracts = append(contracts, rsp.ContractAddresses...)
+
+		nextKey = rsp.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.Equal(t, expected, contracts)
+
+	rsp, err := grpcQuerier.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{CreatorAddress: walletB.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{otherContract.String()}, rsp.ContractAddresses)
+
+	// an address without contracts gets an empty list
+	rsp, err = grpcQuerier.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{CreatorAddress: contractAddress(9, 9, nil).String()})
+	require.NoError(t, err)
+	require.Empty(t, rsp.ContractAddresses)
+
+	_, err = grpcQuerier.ContractsByCreator(sdk.WrapSDKContext(ctx), &types.QueryContractsByCreatorRequest{CreatorAddress: "invalid"})
+	require.Error(t, err)
 }
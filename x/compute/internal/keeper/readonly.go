@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// readOnlyKey is the context key ExecuteReadOnly marks its execution with
+type readOnlyKey struct{}
+
+// ExecuteReadOnly executes the contract instance like Execute, but in a branch of ctx that is
+// discarded afterwards. It fails with ErrReadOnlyViolation when the contract writes to its state
+// or returns messages to dispatch, so a successful call is known not to have changed anything.
+// Events of the execution are discarded with the branch; the result data is returned.
+func (k Keeper) ExecuteReadOnly(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, callbackSig []byte) (*sdk.Result, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.Execute(cacheCtx.WithValue(readOnlyKey{}, true), contractAddress, caller, msg, sdk.NewCoins(), callbackSig, wasmTypes.HandleTypeExecute)
+}
+
+// readOnlyStore drops the writes of a read-only execution and remembers that there were any
+type readOnlyStore struct {
+	sdk.KVStore
+	written bool
+}
+
+// newReadOnlyStore wraps store if ctx is a read-only execution, and returns nil otherwise
+func newReadOnlyStore(ctx sdk.Context, store sdk.KVStore) *readOnlyStore {
+	if readOnly, _ := ctx.Value(readOnlyKey{}).(bool); !readOnly {
+		return nil
+	}
+	return &readOnlyStore{KVStore: store}
+}
+
+// Set implements KVStore
+func (s *readOnlyStore) Set(_, _ []byte) {
+	s.written = true
+}
+
+// Delete implements KVStore
+func (s *readOnlyStore) Delete(_ []byte) {
+	s.written = true
+}
+
+// check fails with ErrReadOnlyViolation if the contract wrote to its state or returned messages
+// in response. It accepts everything on a nil store, i.e. when the execution isn't read-only.
+func (s *readOnlyStore) check(contractAddress sdk.AccAddress, response interface{}) error {
+	if s == nil {
+		return nil
+	}
+	if s.written {
+		return sdkerrors.Wrapf(types.ErrReadOnlyViolation, "contract %s wrote to its state", contractAddress)
+	}
+
+	var messages int
+	switch res := response.(type) {
+	case *v010wasmTypes.HandleResponse:
+		messages = len(res.Messages)
+	case *v1wasmTypes.Response:
+		messages = len(res.Messages)
+	}
+	if messages > 0 {
+		return sdkerrors.Wrapf(types.ErrReadOnlyViolation, "contract %s returned %d messages", contractAddress, messages)
+	}
+	return nil
+}
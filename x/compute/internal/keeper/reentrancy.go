@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// executingContractsKey is the context key of the reentrancy protected contracts that are
+// executing further up the call stack. The submessages of a contract are dispatched with a
+// context derived from the one the contract was called with, so the list is extended on the way
+// down and forgotten once the call returns.
+type executingContractsKey struct{}
+
+// enterContract returns a context that records contractAddress as executing. It fails with
+// ErrReentrancy when the contract is already executing in ctx.
+func enterContract(ctx sdk.Context, contractAddress sdk.AccAddress) (sdk.Context, error) {
+	executing, _ := ctx.Value(executingContractsKey{}).([]string)
+	for _, addr := range executing {
+		if addr == string(contractAddress) {
+			return ctx, sdkerrors.Wrapf(types.ErrReentrancy, "contract %s is already executing", contractAddress)
+		}
+	}
+
+	// copy, so calls dispatched next to each other don't share the backing array
+	entered := make([]string, len(executing), len(executing)+1)
+	copy(entered, executing)
+	return ctx.WithValue(executingContractsKey{}, append(entered, string(contractAddress))), nil
+}
@@ -29,10 +29,13 @@ func (k Keeper) ibcContractCall(ctx sdk.Context,
 
 	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
 
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return "", err
 	}
+	if contractInfo.Terminated {
+		return nil, sdkerrors.Wrap(types.ErrContractTerminated, contractAddress.String())
+	}
 
 	contractKey, err := k.GetContractKey(ctx, contractAddress)
 	if err != nil {
@@ -55,9 +58,17 @@ func (k Keeper) ibcContractCall(ctx sdk.Context,
 		Plugins: k.queryPlugins,
 	}
 
-	gas := gasForContract(ctx)
-	res, gasUsed, err := k.wasmer.Execute(codeInfo.CodeHash, env, msgBz, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, sigInfo, callType)
-	consumeGas(ctx, gasUsed)
+	gas := k.gasForContract(ctx)
+	storage := k.newContractStorage(ctx, contractAddress, prefixStore)
+	res, gasUsed, err := k.wasmer.Execute(codeInfo.CodeHash, env, msgBz, storage, cosmwasmAPI, querier, ctx.GasMeter(), gas, sigInfo, callType)
+	k.consumeGas(ctx, gasUsed)
+
+	if storageErr := k.commitContractStorage(ctx, contractAddress, storage); storageErr != nil {
+		return nil, storageErr
+	}
+	if err == nil {
+		k.refundFreedStorageGas(ctx, storage)
+	}
 
 	return res, err
 }
@@ -195,6 +206,10 @@ func (k Keeper) OnRecvPacket(
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading Compute module: ibc-recv-packet")
 
+	if !k.isIbcReceiverWhitelisted(ctx, contractAddress) {
+		return nil, sdkerrors.Wrapf(types.ErrIbcReceiverNotWhitelisted, "contract %s", contractAddress)
+	}
+
 	msgBz, err := json.Marshal(msg)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "ibc-recv-packet")
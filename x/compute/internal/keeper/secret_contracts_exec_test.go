@@ -17,14 +17,19 @@ import (
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 
 	v010types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 	"golang.org/x/exp/slices"
 
 	cosmwasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	"github.com/stretchr/testify/require"
 	"gonum.org/v1/gonum/stat/combin"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	stypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	ibcchanneltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -2866,3 +2871,597 @@ func TestCallbackEnvTxHash(t *testing.T) {
 		events,
 	)
 }
+
+func TestPausedContractRejectsExecutions(t *testing.T) {
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, callerAddr, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	_, _, pausedAddr, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	err := keeper.SetContractPaused(ctx, pausedAddr, walletB, true)
+	require.Equal(t, "caller is not the admin: unauthorized", err.Error())
+
+	err = keeper.SetContractPaused(ctx, pausedAddr, walletA, true)
+	require.NoError(t, err)
+	require.True(t, keeper.GetContractInfo(ctx, pausedAddr).Paused)
+
+	t.Run("direct execution", func(t *testing.T) {
+		_, _, _, _, _, execErr := execHelperMultipleCoins(t, keeper, ctx, pausedAddr, walletA, privKeyA, `{"c":{"x":1,"y":1}}`, false, true, defaultGasForTests, sdk.NewCoins(), 0)
+		require.NotNil(t, execErr.GenericErr)
+		require.Contains(t, execErr.GenericErr.Msg, "contract is paused")
+	})
+
+	t.Run("execution via a sub-message", func(t *testing.T) {
+		_, _, _, _, _, execErr := execHelper(t, keeper, ctx, callerAddr, walletA, privKeyA, fmt.Sprintf(`{"call_to_exec":{"addr":"%s","code_hash":"%s","msg":"%s"}}`, pausedAddr, codeHash, `{\"c\":{\"x\":1,\"y\":1}}`), false, true, defaultGasForTests, 0)
+		require.NotEmpty(t, execErr)
+		require.Contains(t, execErr.Error(), "contract is paused")
+	})
+
+	t.Run("queries are still allowed", func(t *testing.T) {
+		_, qErr := queryHelper(t, keeper, ctx, pausedAddr, `{"get_contract_version":{}}`, true, true, defaultGasForTests)
+		require.Empty(t, qErr)
+	})
+
+	err = keeper.SetContractPaused(ctx, pausedAddr, walletA, false)
+	require.NoError(t, err)
+
+	_, _, _, _, _, execErr := execHelper(t, keeper, ctx, pausedAddr, walletA, privKeyA, `{"c":{"x":1,"y":1}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+}
+
+func TestTerminateContract(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)))
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 300))
+	require.NoError(t, keeper.bankKeeper.SendCoins(ctx, walletA, contractAddress, funds))
+	recipientBalance := keeper.bankKeeper.GetAllBalances(ctx, walletB)
+
+	msg := types.MsgTerminateContract{Contract: contractAddress.String(), Recipient: walletB.String()}
+	// only the contract itself can dispatch the message
+	require.Equal(t, []sdk.AccAddress{contractAddress}, msg.GetSigners())
+
+	_, err := NewMsgServerImpl(keeper).TerminateContract(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+
+	require.True(t, keeper.GetContractInfo(ctx, contractAddress).Terminated)
+	require.True(t, keeper.bankKeeper.GetAllBalances(ctx, contractAddress).IsZero())
+	require.Equal(t, recipientBalance.Add(funds...), keeper.bankKeeper.GetAllBalances(ctx, walletB))
+
+	t.Run("executions are rejected", func(t *testing.T) {
+		_, _, _, _, _, execErr := execHelperMultipleCoins(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"c":{"x":1,"y":1}}`, false, true, defaultGasForTests, sdk.NewCoins(), 0)
+		require.NotNil(t, execErr.GenericErr)
+		require.Contains(t, execErr.GenericErr.Msg, "contract is terminated")
+	})
+
+	t.Run("queries are rejected", func(t *testing.T) {
+		_, err := keeper.QuerySmart(ctx, contractAddress, []byte(`{"get_contract_version":{}}`), true)
+		require.ErrorIs(t, err, types.ErrContractTerminated)
+	})
+
+	t.Run("replies are rejected", func(t *testing.T) {
+		_, err := keeper.reply(ctx, contractAddress, v1types.Reply{}, make([]byte, 64), cosmwasm.SigInfo{})
+		require.ErrorIs(t, err, types.ErrContractTerminated)
+	})
+
+	t.Run("IBC calls are rejected", func(t *testing.T) {
+		_, err := keeper.OnOpenChannel(ctx, contractAddress, v1types.IBCChannelOpenMsg{})
+		require.ErrorContains(t, err, "contract is terminated")
+		err = keeper.OnConnectChannel(ctx, contractAddress, v1types.IBCChannelConnectMsg{})
+		require.ErrorContains(t, err, "contract is terminated")
+		err = keeper.OnCloseChannel(ctx, contractAddress, v1types.IBCChannelCloseMsg{})
+		require.ErrorContains(t, err, "contract is terminated")
+		_, err = keeper.OnRecvPacket(ctx, contractAddress, v1types.IBCPacketReceiveMsg{})
+		require.ErrorContains(t, err, "contract is terminated")
+		err = keeper.OnAckPacket(ctx, contractAddress, v1types.IBCPacketAckMsg{})
+		require.ErrorContains(t, err, "contract is terminated")
+		err = keeper.OnTimeoutPacket(ctx, contractAddress, v1types.IBCPacketTimeoutMsg{})
+		require.ErrorContains(t, err, "contract is terminated")
+	})
+
+	t.Run("contract info is still available", func(t *testing.T) {
+		contractInfo := keeper.GetContractInfo(ctx, contractAddress)
+		require.NotNil(t, contractInfo)
+		require.Equal(t, codeID, contractInfo.CodeID)
+	})
+
+	err = keeper.TerminateContract(ctx, contractAddress, walletB)
+	require.ErrorIs(t, err, types.ErrContractTerminated)
+}
+
+func TestExecuteReadOnly(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	_, _, _, _, _, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"set_state":{"key":"banana","value":"🍌"}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+
+	executeReadOnly := func(msg string) error {
+		msgBz, err := testEncrypt(t, keeper, ctx, contractAddress, 0, []byte(msg))
+		require.NoError(t, err)
+		execCtx := PrepareExecSignedTx(t, keeper, ctx, walletA, privKeyA, msgBz, contractAddress, nil)
+
+		_, err = keeper.ExecuteReadOnly(execCtx, contractAddress, walletA, msgBz, nil)
+		return err
+	}
+
+	t.Run("reading contract succeeds", func(t *testing.T) {
+		events := len(ctx.EventManager().Events())
+
+		require.NoError(t, executeReadOnly(`{"get_state":{"key":"banana"}}`))
+		require.Len(t, ctx.EventManager().Events(), events)
+	})
+
+	t.Run("writing contract is rejected", func(t *testing.T) {
+		usage := keeper.GetContractStorageUsage(ctx, contractAddress)
+
+		err := executeReadOnly(`{"set_state":{"key":"banana","value":"🍎"}}`)
+		require.ErrorIs(t, err, types.ErrReadOnlyViolation)
+
+		require.Equal(t, usage, keeper.GetContractStorageUsage(ctx, contractAddress))
+		_, _, data, _, _, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"get_state":{"key":"banana"}}`, true, true, defaultGasForTests, 0)
+		require.Empty(t, execErr)
+		require.Equal(t, "🍌", string(data))
+	})
+}
+
+func TestContractStorageQuota(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	_, _, _, _, _, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"set_state":{"key":"banana","value":"🍌"}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+
+	used := keeper.GetContractStorageUsage(ctx, contractAddress)
+	require.NotZero(t, used)
+	require.Equal(t, keeper.computeContractStorageUsage(ctx, contractAddress), used)
+
+	params := keeper.GetParams(ctx)
+	params.MaxContractStorageBytes = used + 16
+	keeper.setParams(ctx, params)
+
+	_, _, _, _, _, execErr = execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, fmt.Sprintf(`{"set_state":{"key":"apple","value":"%s"}}`, strings.Repeat("🍎", 64)), false, true, defaultGasForTests, 0)
+	require.NotNil(t, execErr.GenericErr)
+	require.Contains(t, execErr.GenericErr.Msg, "contract is out of storage")
+	require.Equal(t, used, keeper.GetContractStorageUsage(ctx, contractAddress))
+
+	_, _, data, _, _, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"get_state":{"key":"banana"}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+	require.Equal(t, "🍌", string(data))
+
+	// freeing storage is allowed even when the contract is at its limit
+	_, _, _, _, _, execErr = execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"remove_state":{"key":"banana"}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+	require.Less(t, keeper.GetContractStorageUsage(ctx, contractAddress), used)
+}
+
+func TestMaxContractMsgSize(t *testing.T) {
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	msg := types.SecretMsg{
+		CodeHash: []byte(codeHash),
+		Msg:      []byte(`{"empty_log_key_value":{}}`),
+	}
+	execMsgBz, err := wasmCtx.Encrypt(msg.Serialize())
+	require.NoError(t, err)
+
+	execute := func(limit uint64) error {
+		params := keeper.GetParams(ctx)
+		params.MaxContractMsgSize = limit
+		keeper.setParams(ctx, params)
+
+		ctx := PrepareExecSignedTx(t, keeper, ctx, walletA, privKeyA, execMsgBz, contractAddress, sdk.NewCoins())
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+		_, err := keeper.Execute(ctx, contractAddress, walletA, execMsgBz, sdk.NewCoins(), nil, cosmwasm.HandleTypeExecute)
+		return err
+	}
+
+	// exactly at the limit
+	require.NoError(t, execute(uint64(len(execMsgBz))))
+
+	// one byte over the limit is rejected before the message reaches the enclave
+	err = execute(uint64(len(execMsgBz)) - 1)
+	require.ErrorIs(t, err, types.ErrLimit)
+	require.Contains(t, err.Error(), fmt.Sprintf("contract message of %d bytes exceeds the limit of %d bytes", len(execMsgBz), len(execMsgBz)-1))
+}
+
+func TestComputeGasMultiplier(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	setMultiplier := func(multiplier uint64) {
+		params := keeper.GetParams(ctx)
+		params.ComputeGasMultiplier = multiplier
+		keeper.setParams(ctx, params)
+	}
+
+	require.Equal(t, types.DefaultComputeGasMultiplier, keeper.GetParams(ctx).ComputeGasMultiplier)
+	_, _, _, _, gasUsed, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"empty_log_key_value":{}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+
+	setMultiplier(3)
+	_, _, _, _, multipliedGasUsed, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"empty_log_key_value":{}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+
+	// only the gas used inside the enclave is multiplied, not e.g. the instance cost
+	require.Greater(t, multipliedGasUsed, gasUsed)
+	require.Less(t, multipliedGasUsed, 3*gasUsed)
+
+	meterCtx := ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	keeper.consumeGas(meterCtx, 5*types.GasMultiplier)
+	require.Equal(t, uint64(3*(5+1)), meterCtx.GasMeter().GasConsumed())
+	require.Equal(t, (1_000_000-uint64(18))/3*types.GasMultiplier, keeper.gasForContract(meterCtx))
+}
+
+func TestReentrancyProtection(t *testing.T) {
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	encrypt := func(plaintext string) []byte {
+		msg := types.SecretMsg{
+			CodeHash: []byte(codeHash),
+			Msg:      []byte(plaintext),
+		}
+		msgBz, err := wasmCtx.Encrypt(msg.Serialize())
+		require.NoError(t, err)
+		return msgBz
+	}
+
+	initMsgBz := encrypt(`{"nop":{}}`)
+	initCtx := PrepareInitSignedTx(t, keeper, ctx, walletA, nil, privKeyA, initMsgBz, codeID, sdk.NewCoins())
+	initCtx = initCtx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+	protected, _, err := keeper.InstantiateWithOptions(initCtx, codeID, walletA, nil, initMsgBz, "protected", sdk.NewCoins(), nil, InstantiateOptions{ReentrancyProtected: true})
+	require.NoError(t, err)
+	require.True(t, keeper.GetContractInfo(ctx, protected).ReentrancyProtected)
+
+	_, _, intermediary, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	require.False(t, keeper.GetContractInfo(ctx, intermediary).ReentrancyProtected)
+
+	execute := func(contractAddress sdk.AccAddress, plaintext string) error {
+		execMsgBz := encrypt(plaintext)
+		ctx := PrepareExecSignedTx(t, keeper, ctx, walletA, privKeyA, execMsgBz, contractAddress, sdk.NewCoins())
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+		_, err := keeper.Execute(ctx, contractAddress, walletA, execMsgBz, sdk.NewCoins(), nil, cosmwasm.HandleTypeExecute)
+		return err
+	}
+	callToExec := func(addr sdk.AccAddress, msg string) string {
+		return fmt.Sprintf(`{"call_to_exec":{"addr":"%s","code_hash":"%s","msg":"%s"}}`, addr, codeHash, strings.ReplaceAll(msg, `"`, `\"`))
+	}
+	c := `{"c":{"x":1,"y":1}}`
+
+	// calls into other contracts and queries of itself are still allowed
+	require.NoError(t, execute(protected, callToExec(intermediary, c)))
+	require.NoError(t, execute(protected, fmt.Sprintf(`{"call_to_query":{"addr":"%s","code_hash":"%s","msg":"%s"}}`, protected, codeHash, `{\"receive_external_query\":{\"num\":1}}`)))
+
+	// a direct call back into the protected contract
+	err = execute(protected, callToExec(protected, c))
+	require.ErrorIs(t, err, types.ErrReentrancy)
+
+	// an indirect call back into the protected contract through the intermediary
+	err = execute(protected, callToExec(intermediary, fmt.Sprintf(`{"b":{"contract_addr":"%s","code_hash":"%s","x":1,"y":1}}`, protected, codeHash)))
+	require.ErrorIs(t, err, types.ErrReentrancy)
+
+	// contracts that didn't opt in can still be reentered
+	require.NoError(t, execute(intermediary, callToExec(intermediary, c)))
+}
+
+func TestContractSendWhitelist(t *testing.T) {
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)))
+
+	encrypt := func(plaintext string) []byte {
+		msg := types.SecretMsg{
+			CodeHash: []byte(codeHash),
+			Msg:      []byte(plaintext),
+		}
+		msgBz, err := wasmCtx.Encrypt(msg.Serialize())
+		require.NoError(t, err)
+		return msgBz
+	}
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+	initMsgBz := encrypt(`{"nop":{}}`)
+	initCtx := PrepareInitSignedTx(t, keeper, ctx, walletA, nil, privKeyA, initMsgBz, codeID, deposit)
+	initCtx = initCtx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+	contractAddress, _, err := keeper.InstantiateWithOptions(initCtx, codeID, walletA, nil, initMsgBz, "restricted", deposit, nil, InstantiateOptions{SendWhitelist: []string{walletB.String()}})
+	require.NoError(t, err)
+	require.Equal(t, []string{walletB.String()}, keeper.GetContractInfo(ctx, contractAddress).SendWhitelist)
+
+	sendTo := func(recipient sdk.AccAddress) error {
+		amount := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+		execMsgBz := encrypt(fmt.Sprintf(`{"bank_msg_send":{"to":"%s","amount":%s}}`, recipient, CoinsToInput(amount)))
+		ctx := PrepareExecSignedTx(t, keeper, ctx, walletA, privKeyA, execMsgBz, contractAddress, sdk.NewCoins())
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+		_, err := keeper.Execute(ctx, contractAddress, walletA, execMsgBz, sdk.NewCoins(), nil, cosmwasm.HandleTypeExecute)
+		return err
+	}
+
+	balanceB := keeper.bankKeeper.GetBalance(ctx, walletB, "denom")
+	require.NoError(t, sendTo(walletB))
+	require.Equal(t, balanceB.AddAmount(sdk.NewInt(10)), keeper.bankKeeper.GetBalance(ctx, walletB, "denom"))
+
+	balanceA := keeper.bankKeeper.GetBalance(ctx, walletA, "denom")
+	err = sendTo(walletA)
+	require.ErrorIs(t, err, types.ErrSendNotWhitelisted)
+	require.Equal(t, balanceA, keeper.bankKeeper.GetBalance(ctx, walletA, "denom"))
+
+	t.Run("contracts without a whitelist can send anywhere", func(t *testing.T) {
+		_, _, unrestricted, _, initErr := initHelperImpl(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests, -1, deposit)
+		require.Empty(t, initErr)
+		require.Empty(t, keeper.GetContractInfo(ctx, unrestricted).SendWhitelist)
+
+		_, _, _, _, _, execErr := execHelper(t, keeper, ctx, unrestricted, walletA, privKeyA, fmt.Sprintf(`{"bank_msg_send":{"to":"%s","amount":%s}}`, walletA, CoinsToInput(sdk.NewCoins(sdk.NewInt64Coin("denom", 10)))), true, true, defaultGasForTests, 0)
+		require.Empty(t, execErr)
+	})
+
+	t.Run("authz grants and execs are rejected", func(t *testing.T) {
+		encodingConfig := MakeEncodingConfig()
+		handler := NewSDKMessageHandler(baseapp.NewMsgServiceRouter(), nil, DefaultEncoders(nil, encodingConfig.Marshaler), keeper, keeper.bankKeeper)
+
+		for _, msg := range []sdk.Msg{
+			&authz.MsgGrant{Granter: contractAddress.String(), Grantee: walletA.String()},
+			&authz.MsgExec{Grantee: contractAddress.String()},
+		} {
+			bz, err := encodingConfig.Marshaler.Marshal(msg.(codec.ProtoMarshaler))
+			require.NoError(t, err)
+
+			_, _, err = handler.DispatchMsg(ctx, contractAddress, "", v1types.CosmosMsg{
+				Stargate: &v1types.StargateMsg{TypeURL: sdk.MsgTypeURL(msg), Value: bz},
+			})
+			require.ErrorIs(t, err, types.ErrSendNotWhitelisted, sdk.MsgTypeURL(msg))
+		}
+	})
+
+	t.Run("termination only sends to whitelisted recipients", func(t *testing.T) {
+		balance := keeper.bankKeeper.GetAllBalances(ctx, contractAddress)
+		require.False(t, balance.IsZero())
+
+		err := keeper.TerminateContract(ctx, contractAddress, walletA)
+		require.ErrorIs(t, err, types.ErrSendNotWhitelisted)
+		require.False(t, keeper.GetContractInfo(ctx, contractAddress).Terminated)
+
+		balanceB := keeper.bankKeeper.GetAllBalances(ctx, walletB)
+		require.NoError(t, keeper.TerminateContract(ctx, contractAddress, walletB))
+		require.Equal(t, balanceB.Add(balance...), keeper.bankKeeper.GetAllBalances(ctx, walletB))
+	})
+}
+
+func TestExecuteCorrelated(t *testing.T) {
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	execute := func(sender sdk.AccAddress, privKey crypto.PrivKey, correlationID string) (*sdk.Result, error) {
+		msg := types.SecretMsg{
+			CodeHash: []byte(codeHash),
+			Msg:      []byte(`{"empty_log_key_value":{}}`),
+		}
+		execMsgBz, err := wasmCtx.Encrypt(msg.Serialize())
+		require.NoError(t, err)
+
+		ctx := PrepareExecSignedTx(t, keeper, ctx, sender, privKey, execMsgBz, contractAddress, sdk.NewCoins())
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+		return keeper.ExecuteCorrelated(ctx, contractAddress, sender, execMsgBz, sdk.NewCoins(), nil, correlationID)
+	}
+
+	// both senders use the same id and get their own result
+	resA, err := execute(walletA, privKeyA, "order-1")
+	require.NoError(t, err)
+	resB, err := execute(walletB, privKeyB, "order-1")
+	require.NoError(t, err)
+
+	resultA := keeper.GetExecutionResultByCorrelationID(ctx, walletA, "order-1")
+	require.NotNil(t, resultA)
+	require.Equal(t, contractAddress.String(), resultA.ContractAddress)
+	require.Equal(t, resA.Data, resultA.Data)
+	require.Equal(t, ctx.BlockHeight(), resultA.Height)
+
+	resultB := keeper.GetExecutionResultByCorrelationID(ctx, walletB, "order-1")
+	require.NotNil(t, resultB)
+	require.Equal(t, resB.Data, resultB.Data)
+
+	// an id can only be used once per sender
+	_, err = execute(walletA, privKeyA, "order-1")
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// executions without an id don't record anything
+	_, err = execute(walletA, privKeyA, "")
+	require.NoError(t, err)
+	require.Nil(t, keeper.GetExecutionResultByCorrelationID(ctx, walletA, ""))
+	require.Nil(t, keeper.GetExecutionResultByCorrelationID(ctx, walletA, "order-2"))
+
+	grpcQuerier := NewGrpcQuerier(keeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	rsp, err := grpcQuerier.ResultByCorrelationId(goCtx, &types.QueryResultByCorrelationIdRequest{SenderAddress: walletB.String(), CorrelationId: "order-1"})
+	require.NoError(t, err)
+	require.Equal(t, *resultB, rsp.Result)
+
+	_, err = grpcQuerier.ResultByCorrelationId(goCtx, &types.QueryResultByCorrelationIdRequest{SenderAddress: walletB.String(), CorrelationId: "order-2"})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestPruneExecutionResults(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, sender := keyPubAddr()
+	result := types.ContractExecutionResult{ContractAddress: contractAddress(1, 1, nil).String(), Height: 10}
+	keeper.setExecutionResult(ctx, sender, "order-1", result)
+
+	keeper.PruneExecutionResults(ctx.WithBlockHeight(result.Height + types.ExecutionResultRetention - 1))
+	require.Equal(t, &result, keeper.GetExecutionResultByCorrelationID(ctx, sender, "order-1"))
+
+	keeper.PruneExecutionResults(ctx.WithBlockHeight(result.Height + types.ExecutionResultRetention))
+	require.Nil(t, keeper.GetExecutionResultByCorrelationID(ctx, sender, "order-1"))
+	require.False(t, ctx.KVStore(keeper.storeKey).Has(types.GetExecutionResultByHeightKey(result.Height, sender, "order-1")))
+}
+
+func TestMaxBlockComputeGas(t *testing.T) {
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	msgServer := NewMsgServerImpl(keeper)
+	executeMsg := func(ctx sdk.Context, contractMsg string) (uint64, error) {
+		msg := types.SecretMsg{
+			CodeHash: []byte(codeHash),
+			Msg:      []byte(contractMsg),
+		}
+		execMsgBz, err := wasmCtx.Encrypt(msg.Serialize())
+		require.NoError(t, err)
+
+		ctx = PrepareExecSignedTx(t, keeper, ctx, walletA, privKeyA, execMsgBz, contractAddress, sdk.NewCoins())
+		ctx = ctx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+		_, err = msgServer.ExecuteContract(sdk.WrapSDKContext(ctx), &types.MsgExecuteContract{
+			Sender:   walletA,
+			Contract: contractAddress,
+			Msg:      execMsgBz,
+		})
+		return ctx.GasMeter().GasConsumed(), err
+	}
+	execute := func(ctx sdk.Context) (uint64, error) {
+		return executeMsg(ctx, `{"empty_log_key_value":{}}`)
+	}
+
+	// unlimited by default
+	gasPerExecution, err := execute(ctx)
+	require.NoError(t, err)
+	require.Zero(t, keeper.blockComputeGasUsed())
+
+	params := keeper.GetParams(ctx)
+	params.MaxBlockComputeGas = 2*gasPerExecution + gasPerExecution/2
+	keeper.setParams(ctx, params)
+
+	// failed executions count too
+	_, err = executeMsg(ctx, `{"no_such_msg":{}}`)
+	require.Error(t, err)
+	require.Positive(t, keeper.blockComputeGasUsed())
+
+	keeper.ResetBlockComputeGas()
+	for i := 1; i <= 3; i++ {
+		_, err := execute(ctx)
+		require.NoError(t, err, "execution %d", i)
+	}
+	require.GreaterOrEqual(t, keeper.blockComputeGasUsed(), params.MaxBlockComputeGas)
+
+	// the block used up its limit
+	_, err = execute(ctx)
+	require.ErrorIs(t, err, types.ErrBlockComputeGasExceeded)
+
+	// CheckTx and simulations aren't limited and don't count
+	used := keeper.blockComputeGasUsed()
+	_, err = execute(ctx.WithIsCheckTx(true))
+	require.NoError(t, err)
+	require.Equal(t, used, keeper.blockComputeGasUsed())
+
+	// the next block starts from zero
+	keeper.ResetBlockComputeGas()
+	require.Zero(t, keeper.blockComputeGasUsed())
+	_, err = execute(ctx)
+	require.NoError(t, err)
+}
+
+func TestMaxInstantiatesPerBlockPerAccount(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	instantiate := func(creator sdk.AccAddress, privKey crypto.PrivKey, wasmCallCount int64) cosmwasm.StdError {
+		_, _, _, _, err := initHelperImpl(t, keeper, ctx, codeID, creator, nil, privKey, `{"nop":{}}`, false, true, defaultGasForTests, wasmCallCount, sdk.NewCoins())
+		return err
+	}
+
+	// unlimited by default
+	for i := 0; i < 3; i++ {
+		require.Empty(t, instantiate(walletA, privKeyA, -1))
+	}
+	keeper.ResetInstantiateCounts(ctx)
+
+	params := keeper.GetParams(ctx)
+	params.MaxInstantiatesPerBlockPerAccount = 2
+	keeper.setParams(ctx, params)
+
+	require.Empty(t, instantiate(walletA, privKeyA, -1))
+	require.Empty(t, instantiate(walletA, privKeyA, -1))
+
+	initErr := instantiate(walletA, privKeyA, 0)
+	require.NotNil(t, initErr.GenericErr)
+	require.Contains(t, initErr.GenericErr.Msg, "instantiate limit per block exceeded")
+
+	// the limit is per account
+	require.Empty(t, instantiate(walletB, privKeyB, -1))
+
+	// and starts over in the next block
+	keeper.ResetInstantiateCounts(ctx)
+	require.Empty(t, instantiate(walletA, privKeyA, -1))
+
+	// exempt accounts aren't limited
+	params.InstantiateLimitExempt = []string{walletA.String()}
+	keeper.setParams(ctx, params)
+	for i := 0; i < 3; i++ {
+		require.Empty(t, instantiate(walletA, privKeyA, -1))
+	}
+}
+
+func TestStorageDeletionRefund(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	setRefundPercent := func(percent uint64) {
+		params := keeper.GetParams(ctx)
+		params.StorageDeletionRefundPercent = percent
+		keeper.setParams(ctx, params)
+	}
+
+	removeGasUsed := func() uint64 {
+		_, _, _, _, _, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, fmt.Sprintf(`{"set_state":{"key":"apple","value":"%s"}}`, strings.Repeat("🍎", 64)), true, true, defaultGasForTests, 0)
+		require.Empty(t, execErr)
+		_, _, _, _, gasUsed, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"remove_state":{"key":"apple"}}`, true, true, defaultGasForTests, 0)
+		require.Empty(t, execErr)
+		return gasUsed
+	}
+
+	// disabled by default
+	require.Zero(t, keeper.GetParams(ctx).StorageDeletionRefundPercent)
+	gasUsed := removeGasUsed()
+
+	setRefundPercent(100)
+	refundedGasUsed := removeGasUsed()
+	require.Less(t, refundedGasUsed, gasUsed)
+
+	// a refund for a deleted entry that was never written isn't given
+	_, _, _, _, noopGasUsed, execErr := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"remove_state":{"key":"apple"}}`, true, true, defaultGasForTests, 0)
+	require.Empty(t, execErr)
+	require.Greater(t, noopGasUsed, refundedGasUsed)
+
+	// the refund is the share of the per-byte write gas of the freed bytes
+	setRefundPercent(50)
+	meterCtx := ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	storage := &contractStorage{freed: 100, startGas: meterCtx.GasMeter().GasConsumed()}
+	meterCtx.GasMeter().ConsumeGas(100_000, "test")
+	keeper.refundFreedStorageGas(meterCtx, storage)
+	require.Equal(t, uint64(100_000-100*stypes.KVGasConfig().WriteCostPerByte/2), meterCtx.GasMeter().GasConsumed())
+
+	// capped at MaxStorageRefundPercent of the gas the call used
+	meterCtx = ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	meterCtx.GasMeter().ConsumeGas(7_000, "before the call")
+	storage = &contractStorage{freed: 100, startGas: meterCtx.GasMeter().GasConsumed()}
+	meterCtx.GasMeter().ConsumeGas(1_000, "test")
+	keeper.refundFreedStorageGas(meterCtx, storage)
+	require.Equal(t, uint64(8_000-1_000*types.MaxStorageRefundPercent/100), meterCtx.GasMeter().GasConsumed())
+}
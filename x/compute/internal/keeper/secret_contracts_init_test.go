@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v010cosmwasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
@@ -1131,3 +1132,94 @@ func TestInitEnvTxHash(t *testing.T) {
 		events,
 	)
 }
+
+func TestInitInstantiatePermission(t *testing.T) {
+	ctx, keeper, _, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	wasmCode, err := os.ReadFile(TestContractPaths[v1Contract])
+	require.NoError(t, err)
+
+	for name, spec := range map[string]struct {
+		permission  *types.AccessConfig
+		expAllowedA bool
+		expAllowedB bool
+	}{
+		"default":          {permission: nil, expAllowedA: true, expAllowedB: true},
+		"nobody":           {permission: &types.AllowNobody},
+		"only address":     {permission: &types.AccessConfig{Permission: types.AccessTypeOnlyAddress, Address: walletA}, expAllowedA: true},
+		"any of addresses": {permission: &types.AccessConfig{Permission: types.AccessTypeAnyOfAddresses, Addresses: []sdk.AccAddress{walletA, walletB}}, expAllowedA: true, expAllowedB: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			codeID, err := keeper.CreateWithOptions(ctx, walletA, wasmCode, "", "", CreateOptions{InstantiatePermission: spec.permission})
+			require.NoError(t, err)
+
+			for _, actor := range []struct {
+				addr    sdk.AccAddress
+				privKey crypto.PrivKey
+				allowed bool
+			}{
+				{addr: walletA, privKey: privKeyA, allowed: spec.expAllowedA},
+				{addr: walletB, privKey: privKeyB, allowed: spec.expAllowedB},
+			} {
+				if actor.allowed {
+					_, _, _, _, initErr := initHelper(t, keeper, ctx, codeID, actor.addr, nil, actor.privKey, `{"nop":{}}`, true, true, defaultGasForTests)
+					require.Empty(t, initErr)
+					continue
+				}
+
+				_, _, _, _, initErr := initHelperImpl(t, keeper, ctx, codeID, actor.addr, nil, actor.privKey, `{"nop":{}}`, false, true, defaultGasForTests, 0, sdk.NewCoins())
+				require.NotNil(t, initErr.GenericErr)
+				require.Equal(t, fmt.Sprintf("%s is not allowed to instantiate contracts from code %d: unauthorized", actor.addr, codeID), initErr.GenericErr.Msg)
+			}
+		})
+	}
+}
+
+func TestInstantiate2PredictableAddress(t *testing.T) {
+	for _, testContract := range testContracts {
+		t.Run(testContract.CosmWasmVersion, func(t *testing.T) {
+			ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, testContract.WasmFilePath, sdk.NewCoins())
+
+			msg := types.SecretMsg{
+				CodeHash: []byte(codeHash),
+				Msg:      []byte(`{"nop":{}}`),
+			}
+			initMsgBz, err := wasmCtx.Encrypt(msg.Serialize())
+			require.NoError(t, err)
+
+			salt := []byte("predictable")
+			predicted := PredictContractAddress(codeID, walletA, salt)
+
+			instantiate := func(label string) (sdk.AccAddress, error) {
+				ctx := PrepareInitSignedTx(t, keeper, ctx, walletA, nil, privKeyA, initMsgBz, codeID, sdk.NewCoins())
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(defaultGasForTests))
+				contractAddress, _, err := keeper.InstantiateWithOptions(ctx, codeID, walletA, nil, initMsgBz, label, sdk.NewCoins(), nil, InstantiateOptions{Salt: salt})
+				return contractAddress, err
+			}
+
+			contractAddress, err := instantiate("salted")
+			require.NoError(t, err)
+			require.Equal(t, predicted, contractAddress)
+			require.NotNil(t, keeper.GetContractInfo(ctx, contractAddress))
+
+			var salts []types.ContractSalt
+			keeper.IterateContractSalts(ctx, func(contractSalt types.ContractSalt) bool {
+				salts = append(salts, contractSalt)
+				return false
+			})
+			require.Equal(t, []types.ContractSalt{{
+				CodeID:          codeID,
+				Creator:         walletA,
+				Salt:            salt,
+				ContractAddress: contractAddress,
+			}}, salts)
+
+			// the same salt can't be used twice by the same creator for the same code
+			_, err = instantiate("salted again")
+			require.ErrorIs(t, err, types.ErrDuplicate)
+
+			// a different salt results in a different address
+			require.NotEqual(t, predicted, PredictContractAddress(codeID, walletA, []byte("other")))
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	v010types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
@@ -7194,6 +7195,18 @@ func TestCannotChangeAdminIfNotAdmin(t *testing.T) {
 
 				require.Equal(t, updateErr.Error(), "caller is not the admin: unauthorized")
 			})
+
+			t.Run("update: contract as its own admin", func(t *testing.T) {
+				_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"nop":{}}`, true, testContract.IsCosmWasmV1Before, defaultGasForTests)
+				require.Empty(t, err)
+
+				_, updateErr := updateAdminHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, contractAddress, defaultGasForTests)
+
+				require.Equal(t, updateErr.Error(), "new admin cannot be the contract itself: invalid")
+
+				info := keeper.GetContractInfo(ctx, contractAddress)
+				require.Equal(t, info.Admin, walletA.String())
+			})
 		})
 	}
 }
@@ -8264,3 +8277,86 @@ func TestContractInitsContractWithAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestTimelockedMigration(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[migrateContractV1], sdk.NewCoins())
+
+	newCodeId, _ := uploadCode(ctx, t, keeper, TestContractPaths[migrateContractV2], walletA)
+
+	_, _, contractAddress, _, _ := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"Nop":{}}`, true, true, defaultGasForTests)
+
+	executeAfterHeight := ctx.BlockHeight() + types.MinMigrationDelay
+
+	err := keeper.ProposeContractMigration(ctx, contractAddress, walletB, newCodeId, executeAfterHeight)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	err = keeper.ProposeContractMigration(ctx, contractAddress, walletA, newCodeId, ctx.BlockHeight())
+	require.ErrorIs(t, err, types.ErrInvalid)
+	// the challenge window is too short
+	err = keeper.ProposeContractMigration(ctx, contractAddress, walletA, newCodeId, executeAfterHeight-1)
+	require.ErrorIs(t, err, types.ErrInvalid)
+	require.Nil(t, keeper.GetPendingMigration(ctx, contractAddress))
+
+	err = keeper.ProposeContractMigration(ctx, contractAddress, walletA, newCodeId, executeAfterHeight)
+	require.NoError(t, err)
+	err = keeper.ProposeContractMigration(ctx, contractAddress, walletA, newCodeId, executeAfterHeight)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+	require.True(t, keeper.GetContractInfo(ctx, contractAddress).MigrationTimelocked)
+
+	res, err := NewGrpcQuerier(keeper).PendingMigrations(sdk.WrapSDKContext(ctx), &types.QueryPendingMigrationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingMigration{{
+		ContractAddress:    contractAddress.String(),
+		CodeID:             newCodeId,
+		ProposedAtHeight:   ctx.BlockHeight(),
+		ExecuteAfterHeight: executeAfterHeight,
+	}}, res.PendingMigrations)
+
+	// too early, the challenge window ends at executeAfterHeight
+	ctx = ctx.WithBlockHeight(executeAfterHeight)
+	_, migErr := migrateHelper(t, keeper, ctx, newCodeId, contractAddress, walletA, privKeyA, `{"migrate":{}}`, false, true, math.MaxUint64, 0)
+	require.NotEmpty(t, migErr)
+	require.Contains(t, migErr.Error(), "challenge window ends at height")
+	require.Equal(t, codeID, keeper.GetContractInfo(ctx, contractAddress).CodeID)
+
+	// not the proposed code
+	ctx = ctx.WithBlockHeight(executeAfterHeight + 1)
+	_, migErr = migrateHelper(t, keeper, ctx, codeID, contractAddress, walletA, privKeyA, `{"migrate":{}}`, false, true, math.MaxUint64, 0)
+	require.NotEmpty(t, migErr)
+	require.Contains(t, migErr.Error(), "migration is time-locked")
+
+	_, migErr = migrateHelper(t, keeper, ctx, newCodeId, contractAddress, walletA, privKeyA, `{"migrate":{}}`, true, true, math.MaxUint64)
+	require.Empty(t, migErr)
+	require.Equal(t, newCodeId, keeper.GetContractInfo(ctx, contractAddress).CodeID)
+	require.Nil(t, keeper.GetPendingMigration(ctx, contractAddress))
+
+	// the contract stays time-locked
+	_, migErr = migrateHelper(t, keeper, ctx, codeID, contractAddress, walletA, privKeyA, `{"migrate":{}}`, false, true, math.MaxUint64, 0)
+	require.NotEmpty(t, migErr)
+	require.Contains(t, migErr.Error(), "no pending migration")
+}
+
+func TestCancelTimelockedMigration(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[migrateContractV1], sdk.NewCoins())
+
+	newCodeId, _ := uploadCode(ctx, t, keeper, TestContractPaths[migrateContractV2], walletA)
+
+	_, _, contractAddress, _, _ := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"Nop":{}}`, true, true, defaultGasForTests)
+
+	err := keeper.CancelContractMigration(ctx, contractAddress, walletA)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	executeAfterHeight := ctx.BlockHeight() + types.MinMigrationDelay
+	err = keeper.ProposeContractMigration(ctx, contractAddress, walletA, newCodeId, executeAfterHeight)
+	require.NoError(t, err)
+
+	err = keeper.CancelContractMigration(ctx, contractAddress, walletB)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	err = keeper.CancelContractMigration(ctx, contractAddress, walletA)
+	require.NoError(t, err)
+	require.Nil(t, keeper.GetPendingMigration(ctx, contractAddress))
+
+	ctx = ctx.WithBlockHeight(executeAfterHeight + 1)
+	_, migErr := migrateHelper(t, keeper, ctx, newCodeId, contractAddress, walletA, privKeyA, `{"migrate":{}}`, false, true, math.MaxUint64, 0)
+	require.NotEmpty(t, migErr)
+	require.Contains(t, migErr.Error(), "no pending migration")
+}
@@ -291,6 +291,44 @@ func TestInfiniteQueryLoopKilledGracefullyByOOM(t *testing.T) {
 	}
 }
 
+func TestInfiniteQueryLoopCutOffByPerMessageGasLimit(t *testing.T) {
+	for _, testContract := range testContracts {
+		t.Run(testContract.CosmWasmVersion, func(t *testing.T) {
+			ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, testContract.WasmFilePath, sdk.NewCoins())
+
+			_, _, addr, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, testContract.IsCosmWasmV1, defaultGasForTests)
+			require.Empty(t, err)
+
+			keeper.queryGasLimitPerMessage = 50_000
+
+			data, err := queryHelper(t, keeper, ctx, addr, fmt.Sprintf(`{"send_external_query_infinite_loop":{"to":"%s","code_hash":"%s"}}`, addr.String(), codeHash), false, testContract.IsCosmWasmV1, 10*defaultGasForTests)
+
+			require.Empty(t, data)
+			require.NotNil(t, err.GenericErr)
+			require.Contains(t, err.GenericErr.Msg, "query exceeded the per-message gas limit of 50000")
+		})
+	}
+}
+
+func TestPerMessageQueryGasLimitIgnoredInTx(t *testing.T) {
+	for _, testContract := range testContracts {
+		t.Run(testContract.CosmWasmVersion, func(t *testing.T) {
+			ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, testContract.WasmFilePath, sdk.NewCoins())
+
+			_, _, addr, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, testContract.IsCosmWasmV1, defaultGasForTests)
+			require.Empty(t, initErr)
+
+			// the node-local limit would cut off any query, but queries inside a tx use consensus gas only
+			keeper.queryGasLimitPerMessage = 1
+
+			_, _, data, _, _, execErr := execHelper(t, keeper, ctx, addr, walletA, privKeyA, fmt.Sprintf(`{"send_external_query":{"to":"%s","code_hash":"%s"}}`, addr.String(), codeHash), true, testContract.IsCosmWasmV1, defaultGasForTests, 0)
+
+			require.Empty(t, execErr)
+			require.Equal(t, []byte{3}, data)
+		})
+	}
+}
+
 func TestQueryRecursionLimitEnforcedInQueries(t *testing.T) {
 	for _, testContract := range testContracts {
 		t.Run(testContract.CosmWasmVersion, func(t *testing.T) {
@@ -325,7 +325,9 @@ type WasmCounterGasMeter struct {
 	gasMeter    sdk.GasMeter
 }
 
-func (wasmGasMeter *WasmCounterGasMeter) RefundGas(_ stypes.Gas, _ string) {}
+func (wasmGasMeter *WasmCounterGasMeter) RefundGas(amount stypes.Gas, descriptor string) {
+	wasmGasMeter.gasMeter.RefundGas(amount, descriptor)
+}
 
 func (wasmGasMeter *WasmCounterGasMeter) GasConsumed() sdk.Gas {
 	return wasmGasMeter.gasMeter.GasConsumed()
@@ -961,7 +963,7 @@ func fakeUpdateContractAdmin(ctx sdk.Context,
 	}
 
 	// instantiate wasm contract
-	gas := gasForContract(ctx)
+	gas := k.gasForContract(ctx)
 
 	newAdminProof, updateAdminErr := k.wasmer.UpdateAdmin(codeInfo.CodeHash, env, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas, sigInfo, currentAdminToSend, currentAdminProof, newAdmin)
 
@@ -1084,10 +1086,10 @@ func fakeMigrate(ctx sdk.Context,
 	}
 
 	// instantiate wasm contract
-	gas := gasForContract(ctx)
+	gas := k.gasForContract(ctx)
 
 	response, newContractKey, newContractKeyProof, gasUsed, migrateErr := k.wasmer.Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gas, sigInfo, adminToSend, adminProof)
-	consumeGas(ctx, gasUsed)
+	k.consumeGas(ctx, gasUsed)
 
 	if migrateErr != nil {
 		var result []byte
@@ -388,6 +388,51 @@ func TestUnbonding(t *testing.T) {
 	assertSupply(t, ctx, keeper, contractAddr, "53000", sdk.NewInt64Coin("stake", 53000))
 }
 
+func TestContractPortfolio(t *testing.T) {
+	initInfo := initializeStaking(t)
+	defer initInfo.cleanup()
+	ctx, contractAddr := initInfo.ctx, initInfo.contractAddr
+	keeper, stakingKeeper, accKeeper := initInfo.wasmKeeper, initInfo.stakingKeeper, initInfo.accKeeper
+
+	// nothing is delegated yet
+	portfolio := keeper.GetContractPortfolio(ctx, contractAddr)
+	assert.True(t, portfolio.Balance.IsZero())
+	assert.Equal(t, sdk.NewInt64Coin("stake", 0), portfolio.Delegated)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 0), portfolio.Unbonding)
+
+	// bob bonds 80k through the contract and sends it another 5k it keeps liquid
+	full := sdk.NewCoins(sdk.NewInt64Coin("stake", 160000))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 80000))
+	bob, privBob := CreateFakeFundedAccount(ctx, accKeeper, keeper.bankKeeper, full)
+
+	bondBz, err := json.Marshal(StakingHandleMsg{Bond: &struct{}{}})
+	require.NoError(t, err)
+	bondBz, err = testEncrypt(t, keeper, ctx, contractAddr, 0, bondBz)
+	require.NoError(t, err)
+	ctx = PrepareExecSignedTx(t, keeper, ctx, bob, privBob, bondBz, contractAddr, funds)
+	_, err = keeper.Execute(ctx, contractAddr, bob, bondBz, funds, nil, wasmtypes.HandleTypeExecute)
+	require.NoError(t, err)
+
+	liquid := sdk.NewCoins(sdk.NewInt64Coin("stake", 5000))
+	require.NoError(t, keeper.bankKeeper.SendCoins(ctx, bob, contractAddr, liquid))
+
+	ctx = nextBlock(ctx, stakingKeeper, keeper)
+
+	// unbonding 30k leaves 53k delegated and 27k unbonding, the exit tax stays bonded
+	unbondBz, err := json.Marshal(StakingHandleMsg{Unbond: &unbondPayload{Amount: "30000"}})
+	require.NoError(t, err)
+	unbondBz, err = testEncrypt(t, keeper, ctx, contractAddr, 0, unbondBz)
+	require.NoError(t, err)
+	ctx = PrepareExecSignedTx(t, keeper, ctx, bob, privBob, unbondBz, contractAddr, nil)
+	_, err = keeper.Execute(ctx, contractAddr, bob, unbondBz, nil, nil, wasmtypes.HandleTypeExecute)
+	require.NoError(t, err)
+
+	portfolio = keeper.GetContractPortfolio(ctx, contractAddr)
+	assert.Equal(t, liquid, portfolio.Balance)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 53000), portfolio.Delegated)
+	assert.Equal(t, sdk.NewInt64Coin("stake", 27000), portfolio.Unbonding)
+}
+
 func TestReinvest(t *testing.T) {
 	initInfo := initializeStaking(t)
 	defer initInfo.cleanup()
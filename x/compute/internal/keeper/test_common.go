@@ -346,6 +346,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(wasmtypes.ModuleName)
 
 	// this is also used to initialize module accounts (so nil is meaningful here)
 	maccPerms := map[string][]string{
@@ -545,10 +546,12 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 
 	bappTxMngr := baseapp.LastMsgMarkerContainer{}
 
+	wasmSubsp, _ := paramsKeeper.GetSubspace(wasmtypes.ModuleName)
 	keeper := NewKeeper(
 		encodingConfig.Marshaler,
 		*encodingConfig.Amino,
 		keys[wasmtypes.StoreKey],
+		wasmSubsp,
 		authKeeper,
 		bankKeeper,
 		govKeeper,
@@ -571,7 +574,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 		queriers,
 		&bappTxMngr,
 	)
-	// keeper.setParams(ctx, wasmtypes.DefaultParams())
+	keeper.setParams(ctx, wasmtypes.DefaultParams())
 	// add wasm handler so we can loop-back (contracts calling contracts)
 	router.AddRoute(sdk.NewRoute(wasmtypes.RouterKey, TestHandler(keeper)))
 
@@ -629,7 +632,13 @@ func handleInstantiate(ctx sdk.Context, k Keeper, msg *wasmtypes.MsgInstantiateC
 		}
 	}
 
-	contractAddr, data, err := k.Instantiate(ctx, msg.CodeID, msg.Sender, admin, msg.InitMsg, msg.Label, msg.InitFunds, msg.CallbackSig)
+	var contractAddr sdk.AccAddress
+	var data []byte
+	contractAddr, data, err = k.InstantiateWithOptions(ctx, msg.CodeID, msg.Sender, admin, msg.InitMsg, msg.Label, msg.InitFunds, msg.CallbackSig, InstantiateOptions{
+		Salt:                msg.Salt,
+		ReentrancyProtected: msg.ReentrancyProtected,
+		SendWhitelist:       msg.SendWhitelist,
+	})
 	if err != nil {
 		result := sdk.Result{}
 		result.Data = data
@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+)
+
+var (
+	// AllowEverybody lets any account instantiate contracts from a code
+	AllowEverybody = AccessConfig{Permission: AccessTypeEverybody}
+	// AllowNobody prevents every account from instantiating contracts from a code
+	AllowNobody = AccessConfig{Permission: AccessTypeNobody}
+)
+
+// OnlyAddressAccess returns an AccessConfig that allows only the given address
+func OnlyAddressAccess(addr sdk.AccAddress) AccessConfig {
+	return AccessConfig{Permission: AccessTypeOnlyAddress, Address: addr}
+}
+
+// AnyOfAddressesAccess returns an AccessConfig that allows any of the given addresses
+func AnyOfAddressesAccess(addrs ...sdk.AccAddress) AccessConfig {
+	return AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: addrs}
+}
+
+// String returns the proto name of the access type, which JSON encoding relies on
+func (a AccessType) String() string {
+	return proto.EnumName(AccessType_name, int32(a))
+}
+
+func (a AccessConfig) ValidateBasic() error {
+	switch a.Permission {
+	case AccessTypeUndefined:
+		return sdkerrors.Wrap(ErrEmpty, "permission")
+	case AccessTypeNobody, AccessTypeEverybody:
+		if len(a.Address) != 0 || len(a.Addresses) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "addresses are not allowed for this permission")
+		}
+	case AccessTypeOnlyAddress:
+		if len(a.Addresses) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "use address instead of addresses for permission OnlyAddress")
+		}
+		if err := sdk.VerifyAddressFormat(a.Address); err != nil {
+			return sdkerrors.Wrap(err, "address")
+		}
+	case AccessTypeAnyOfAddresses:
+		if len(a.Address) != 0 {
+			return sdkerrors.Wrap(ErrInvalid, "use addresses instead of address for permission AnyOfAddresses")
+		}
+		if len(a.Addresses) == 0 {
+			return sdkerrors.Wrap(ErrEmpty, "addresses")
+		}
+		seen := make(map[string]struct{}, len(a.Addresses))
+		for _, addr := range a.Addresses {
+			if err := sdk.VerifyAddressFormat(addr); err != nil {
+				return sdkerrors.Wrap(err, "addresses")
+			}
+			if _, ok := seen[string(addr)]; ok {
+				return sdkerrors.Wrapf(ErrDuplicate, "address %s", addr)
+			}
+			seen[string(addr)] = struct{}{}
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown permission %d", a.Permission)
+	}
+	return nil
+}
+
+// Allowed returns true when the given account may instantiate under this config
+func (a AccessConfig) Allowed(actor sdk.AccAddress) bool {
+	switch a.Permission {
+	case AccessTypeEverybody:
+		return true
+	case AccessTypeOnlyAddress:
+		return a.Address.Equals(actor)
+	case AccessTypeAnyOfAddresses:
+		for _, addr := range a.Addresses {
+			if addr.Equals(actor) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
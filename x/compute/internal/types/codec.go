@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 // RegisterCodec registers the account types and interface
@@ -16,6 +17,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin", nil)
 	cdc.RegisterConcrete(&MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
+	cdc.RegisterConcrete(&MsgPauseContract{}, "wasm/MsgPauseContract", nil)
+	cdc.RegisterConcrete(&MsgResumeContract{}, "wasm/MsgResumeContract", nil)
+	cdc.RegisterConcrete(&MsgTerminateContract{}, "wasm/MsgTerminateContract", nil)
+	cdc.RegisterConcrete(&MsgProposeContractMigration{}, "wasm/MsgProposeContractMigration", nil)
+	cdc.RegisterConcrete(&MsgCancelContractMigration{}, "wasm/MsgCancelContractMigration", nil)
+	cdc.RegisterConcrete(&ContractExecutionAllowance{}, "wasm/ContractExecutionAllowance", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -27,6 +34,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgMigrateContract{},
 		&MsgUpdateAdmin{},
 		&MsgClearAdmin{},
+		&MsgPauseContract{},
+		&MsgResumeContract{},
+		&MsgTerminateContract{},
+		&MsgProposeContractMigration{},
+		&MsgCancelContractMigration{},
+	)
+	registry.RegisterImplementations(
+		(*feegrant.FeeAllowanceI)(nil),
+		&ContractExecutionAllowance{},
 	)
 }
 
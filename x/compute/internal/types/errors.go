@@ -72,6 +72,36 @@ var (
 
 	// ErrMaxIBCChannels error for maximum number of ibc channels reached
 	ErrMaxIBCChannels = sdkErrors.Register(DefaultCodespace, 22, "max transfer channels")
+
+	// ErrContractPaused error for executing a contract that was paused by its admin
+	ErrContractPaused = sdkErrors.Register(DefaultCodespace, 23, "contract is paused")
+
+	// ErrOutOfStorage error for a contract write that exceeds the per-contract storage limit
+	ErrOutOfStorage = sdkErrors.Register(DefaultCodespace, 24, "contract is out of storage")
+
+	// ErrReentrancy error for executing a reentrancy protected contract while it is already executing
+	ErrReentrancy = sdkErrors.Register(DefaultCodespace, 25, "contract reentrancy")
+
+	// ErrIbcReceiverNotWhitelisted error for an incoming IBC packet to a contract that isn't on the IbcReceiverWhitelist
+	ErrIbcReceiverNotWhitelisted = sdkErrors.Register(DefaultCodespace, 26, "contract is not whitelisted to receive ibc packets")
+
+	// ErrBlockComputeGasExceeded error for a contract message in a block that used up the MaxBlockComputeGas param
+	ErrBlockComputeGasExceeded = sdkErrors.Register(DefaultCodespace, 27, "block compute gas exceeded")
+
+	// ErrContractTerminated error for calling a contract that terminated itself
+	ErrContractTerminated = sdkErrors.Register(DefaultCodespace, 28, "contract is terminated")
+
+	// ErrSendNotWhitelisted error for a bank send of a contract to an address that isn't on its SendWhitelist
+	ErrSendNotWhitelisted = sdkErrors.Register(DefaultCodespace, 29, "recipient is not on the contract's send whitelist")
+
+	// ErrReadOnlyViolation error for a read-only execution that tried to change state
+	ErrReadOnlyViolation = sdkErrors.Register(DefaultCodespace, 30, "read-only execution tried to change state")
+
+	// ErrInstantiateLimitExceeded error for an account that instantiated MaxInstantiatesPerBlockPerAccount contracts in the block already
+	ErrInstantiateLimitExceeded = sdkErrors.Register(DefaultCodespace, 31, "instantiate limit per block exceeded")
+
+	// ErrMigrationTimelocked error for migrating a time-locked contract without a pending migration whose challenge window is over
+	ErrMigrationTimelocked = sdkErrors.Register(DefaultCodespace, 32, "migration is time-locked")
 )
 
 func IsEncryptedErrorCode(code uint32) bool {
@@ -15,14 +15,25 @@ const (
 	EventTypeSudo                = "sudo"
 	EventTypeReply               = "reply"
 	EventTypeUpdateContractAdmin = "update_contract_admin"
+	EventTypeTerminate           = "terminate"
+	EventTypeProposeMigration    = "propose_migration"
+	EventTypeCancelMigration     = "cancel_migration"
+	EventTypeFundCommunityPool   = "fund_community_pool"
 )
 
 // event attributes returned from contract execution
 const (
 	AttributeReservedPrefix = "_"
+	// AttributeIndexedPrefix marks a plaintext attribute the contract wants indexed for
+	// Query/ContractEventsByAttribute, under the key without the prefix. Encrypted attribute keys
+	// are base64, which never contains a colon, so only plaintext attributes can be indexed.
+	AttributeIndexedPrefix = "indexed:"
 
 	AttributeKeyContractAddr = "contract_address"
 	AttributeKeyCodeID       = "code_id"
 	AttributeKeySigner       = "signer"
 	AttributeKeyNewAdmin     = "new_admin_address"
+	AttributeKeyRecipient    = "recipient"
+
+	AttributeKeyExecuteAfterHeight = "execute_after_height"
 )
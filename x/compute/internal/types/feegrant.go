@@ -0,0 +1,124 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// gasCostPerContract is charged for every allowed contract the executed contracts are matched
+// against, like the per message cost of feegrant.AllowedMsgAllowance
+const gasCostPerContract = uint64(10)
+
+var (
+	_ feegrant.FeeAllowanceI             = (*ContractExecutionAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*ContractExecutionAllowance)(nil)
+)
+
+// NewContractExecutionAllowance restricts allowance to transactions that only execute the
+// allowed contracts
+func NewContractExecutionAllowance(allowance feegrant.FeeAllowanceI, allowedContracts []string) (*ContractExecutionAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractExecutionAllowance{
+		Allowance:        any,
+		AllowedContracts: allowedContracts,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *ContractExecutionAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the wrapped fee allowance
+func (a *ContractExecutionAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, sdkerrors.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// Accept implements feegrant.FeeAllowanceI. Every message of the transaction must be a
+// MsgExecuteContract of an allowed contract, the fee is then checked by the wrapped allowance.
+func (a *ContractExecutionAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	allowed := make(map[string]bool, len(a.AllowedContracts))
+	for _, contract := range a.AllowedContracts {
+		ctx.GasMeter().ConsumeGas(gasCostPerContract, "check contract")
+		contractAddress, err := sdk.AccAddressFromBech32(contract)
+		if err != nil {
+			return false, sdkerrors.Wrapf(err, "allowed contract %s", contract)
+		}
+		allowed[string(contractAddress)] = true
+	}
+
+	for _, msg := range msgs {
+		ctx.GasMeter().ConsumeGas(gasCostPerContract, "check contract")
+		execMsg, ok := msg.(*MsgExecuteContract)
+		if !ok {
+			return false, sdkerrors.Wrapf(feegrant.ErrMessageNotAllowed, "%s is not a contract execution", sdk.MsgTypeURL(msg))
+		}
+		if !allowed[string(execMsg.Contract)] {
+			return false, sdkerrors.Wrapf(feegrant.ErrMessageNotAllowed, "contract %s is not allowed", execMsg.Contract)
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil {
+		return false, err
+	}
+
+	a.Allowance, err = codectypes.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return false, err
+	}
+
+	return remove, nil
+}
+
+// ValidateBasic implements feegrant.FeeAllowanceI
+func (a *ContractExecutionAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.AllowedContracts) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "allowed contracts")
+	}
+
+	seen := make(map[string]struct{}, len(a.AllowedContracts))
+	for _, contract := range a.AllowedContracts {
+		contractAddress, err := sdk.AccAddressFromBech32(contract)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "allowed contract %s", contract)
+		}
+		if _, ok := seen[string(contractAddress)]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "allowed contract %s", contract)
+		}
+		seen[string(contractAddress)] = struct{}{}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secret/compute/v1beta1/feegrant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractExecutionAllowance is a fee allowance that only pays for the execution of the allowed
+// contracts
+type ContractExecutionAllowance struct {
+	// Allowance can be any of basic, periodic and filtered fee allowance
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// AllowedContracts are the bech32 addresses of the contracts the grantee can execute
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+}
+
+func (m *ContractExecutionAllowance) Reset()         { *m = ContractExecutionAllowance{} }
+func (m *ContractExecutionAllowance) String() string { return proto.CompactTextString(m) }
+func (*ContractExecutionAllowance) ProtoMessage()    {}
+func (*ContractExecutionAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a273e0bc42991d4, []int{0}
+}
+func (m *ContractExecutionAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExecutionAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExecutionAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExecutionAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExecutionAllowance.Merge(m, src)
+}
+func (m *ContractExecutionAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExecutionAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExecutionAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExecutionAllowance proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ContractExecutionAllowance)(nil), "secret.compute.v1beta1.ContractExecutionAllowance")
+}
+
+func init() {
+	proto.RegisterFile("secret/compute/v1beta1/feegrant.proto", fileDescriptor_3a273e0bc42991d4)
+}
+
+var fileDescriptor_3a273e0bc42991d4 = []byte{
+	// 303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x02, 0x41,
+	0x1c, 0xc6, 0x77, 0x0b, 0x02, 0x37, 0x82, 0x14, 0x09, 0xf3, 0x30, 0x48, 0x10, 0x08, 0xd1, 0x0c,
+	0xd6, 0xcd, 0x9b, 0x86, 0x41, 0x97, 0x0e, 0x76, 0x89, 0x2e, 0x32, 0x3b, 0xfd, 0x9d, 0xa4, 0x75,
+	0xfe, 0x32, 0xf3, 0xdf, 0xd4, 0xb7, 0xe8, 0x1d, 0x7a, 0x85, 0x1e, 0x42, 0x3a, 0x49, 0xa7, 0x8e,
+	0xa5, 0x2f, 0x12, 0xee, 0xec, 0x1a, 0x74, 0x9b, 0x8f, 0xef, 0xf7, 0xcd, 0x37, 0xf3, 0x45, 0xa7,
+	0x0e, 0x94, 0x05, 0x12, 0x0a, 0xc7, 0x93, 0x94, 0x40, 0xbc, 0xb4, 0x62, 0x20, 0xd9, 0x12, 0x43,
+	0x00, 0x6d, 0xa5, 0x21, 0x3e, 0xb1, 0x48, 0x58, 0x39, 0xf2, 0x18, 0xcf, 0x31, 0x9e, 0x63, 0xf5,
+	0xaa, 0x46, 0x8d, 0x19, 0x22, 0x36, 0x27, 0x4f, 0xd7, 0x8f, 0x35, 0xa2, 0x4e, 0x40, 0x64, 0x2a,
+	0x4e, 0x87, 0x42, 0x9a, 0x79, 0x61, 0x29, 0x74, 0x63, 0x74, 0x03, 0x9f, 0xf1, 0xc2, 0x5b, 0x27,
+	0x6f, 0x61, 0x54, 0xbf, 0x42, 0x43, 0x56, 0x2a, 0xea, 0xcd, 0x40, 0xa5, 0x34, 0x42, 0xd3, 0x49,
+	0x12, 0x9c, 0x4a, 0xa3, 0xa0, 0xd2, 0x8b, 0x4a, 0xb2, 0x10, 0xb5, 0xb0, 0x11, 0x36, 0xf7, 0x2f,
+	0xaa, 0xdc, 0x17, 0xf1, 0xa2, 0x88, 0x77, 0xcc, 0xbc, 0x5b, 0xfe, 0x78, 0x3f, 0x3f, 0xb8, 0x06,
+	0xd8, 0x46, 0x6f, 0xfa, 0x7f, 0xc9, 0xca, 0x59, 0x54, 0xce, 0x04, 0x3c, 0x0e, 0x54, 0x5e, 0xe6,
+	0x6a, 0x3b, 0x8d, 0xdd, 0x66, 0xa9, 0x7f, 0x98, 0x1b, 0xc5, 0x23, 0x5c, 0xbb, 0xfc, 0xf9, 0xff,
+	0xaa, 0xee, 0xfd, 0xe2, 0x87, 0x05, 0x8b, 0x15, 0x0b, 0x97, 0x2b, 0x16, 0x7e, 0xaf, 0x58, 0xf8,
+	0xba, 0x66, 0xc1, 0x72, 0xcd, 0x82, 0xaf, 0x35, 0x0b, 0x1e, 0xda, 0x7a, 0x44, 0x4f, 0x69, 0xbc,
+	0xd9, 0x49, 0x38, 0x65, 0x29, 0x91, 0xb1, 0x13, 0x77, 0xd9, 0x76, 0xb7, 0x40, 0x53, 0xb4, 0xcf,
+	0x62, 0xb6, 0xdd, 0x7a, 0x64, 0x08, 0xac, 0x91, 0x89, 0xa0, 0xf9, 0x04, 0x5c, 0xbc, 0x97, 0xfd,
+	0xe2, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff, 0x78, 0x47, 0x90, 0x70, 0x93, 0x01, 0x00, 0x00,
+}
+
+func (m *ContractExecutionAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExecutionAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExecutionAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractExecutionAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractExecutionAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecutionAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecutionAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeegrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeegrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeegrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeegrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeegrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeegrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeegrant = fmt.Errorf("proto: unexpected end of group")
+)
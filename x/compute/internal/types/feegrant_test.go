@@ -0,0 +1,106 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestContractExecutionAllowance(t *testing.T) {
+	allowedContract := sdk.AccAddress(make([]byte, 20))
+	otherContract := sdk.AccAddress(append(make([]byte, 19), 1))
+	sender := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20))
+
+	execute := func(contract sdk.AccAddress) sdk.Msg {
+		return &MsgExecuteContract{Sender: sender, Contract: contract, Msg: []byte("{}")}
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uscrt", 10))
+
+	specs := map[string]struct {
+		contracts  []string
+		msgs       []sdk.Msg
+		spend      sdk.Coins
+		expInvalid bool
+		expReject  bool
+		expRemove  bool
+	}{
+		"allowed contract": {
+			contracts: []string{allowedContract.String()},
+			msgs:      []sdk.Msg{execute(allowedContract)},
+			spend:     sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100)),
+		},
+		"allowed contract spends the limit": {
+			contracts: []string{allowedContract.String()},
+			msgs:      []sdk.Msg{execute(allowedContract)},
+			spend:     fee,
+			expRemove: true,
+		},
+		"fee above the limit": {
+			contracts: []string{allowedContract.String()},
+			msgs:      []sdk.Msg{execute(allowedContract)},
+			spend:     sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1)),
+			expReject: true,
+		},
+		"other contract": {
+			contracts: []string{allowedContract.String()},
+			msgs:      []sdk.Msg{execute(allowedContract), execute(otherContract)},
+			spend:     sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100)),
+			expReject: true,
+		},
+		"not a contract execution": {
+			contracts: []string{allowedContract.String()},
+			msgs:      []sdk.Msg{&banktypes.MsgSend{FromAddress: sender.String(), ToAddress: allowedContract.String(), Amount: fee}},
+			spend:     sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100)),
+			expReject: true,
+		},
+		"no allowed contracts": {
+			spend:      sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100)),
+			expInvalid: true,
+		},
+		"allowed contract not an address": {
+			contracts:  []string{"invalid"},
+			spend:      sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100)),
+			expInvalid: true,
+		},
+		"allowed contract duplicate": {
+			contracts:  []string{allowedContract.String(), allowedContract.String()},
+			spend:      sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100)),
+			expInvalid: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			allowance, err := NewContractExecutionAllowance(&feegrant.BasicAllowance{SpendLimit: spec.spend}, spec.contracts)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if spec.expInvalid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx := sdk.NewContext(nil, tmproto.Header{Time: time.Now()}, false, log.NewNopLogger())
+			remove, err := allowance.Accept(ctx, fee, spec.msgs)
+			if spec.expReject {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expRemove, remove)
+
+			// the wrapped allowance is updated with the spent fee
+			inner, err := allowance.GetAllowance()
+			require.NoError(t, err)
+			require.Equal(t, spec.spend.Sub(fee), inner.(*feegrant.BasicAllowance).SpendLimit)
+		})
+	}
+}
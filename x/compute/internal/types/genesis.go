@@ -12,7 +12,29 @@ func (s Sequence) ValidateBasic() error {
 	return nil
 }
 
+func (s ContractSalt) ValidateBasic() error {
+	if s.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if err := sdk.VerifyAddressFormat(s.Creator); err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	if len(s.Salt) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "salt")
+	}
+	if err := validateSalt(s.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+	if err := sdk.VerifyAddressFormat(s.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	return nil
+}
+
 func (s GenesisState) ValidateBasic() error {
+	if err := s.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
 	for i := range s.Codes {
 		if err := s.Codes[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "code: %d", i)
@@ -28,6 +50,67 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	seenSalts := make(map[string]struct{}, len(s.ContractSalts))
+	for i := range s.ContractSalts {
+		if err := s.ContractSalts[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract salt: %d", i)
+		}
+		key := string(GetContractSaltKey(s.ContractSalts[i].CodeID, s.ContractSalts[i].Creator, s.ContractSalts[i].Salt))
+		if _, ok := seenSalts[key]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract salt: %d", i)
+		}
+		seenSalts[key] = struct{}{}
+	}
+	seenPendingMigrations := make(map[string]struct{}, len(s.PendingMigrations))
+	for i := range s.PendingMigrations {
+		if err := s.PendingMigrations[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "pending migration: %d", i)
+		}
+		if _, ok := seenPendingMigrations[s.PendingMigrations[i].ContractAddress]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "pending migration: %d", i)
+		}
+		seenPendingMigrations[s.PendingMigrations[i].ContractAddress] = struct{}{}
+	}
+	seenExecutionResults := make(map[string]struct{}, len(s.CorrelatedExecutionResults))
+	for i := range s.CorrelatedExecutionResults {
+		if err := s.CorrelatedExecutionResults[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "correlated execution result: %d", i)
+		}
+		key := string(GetExecutionResultByCorrelationIDKey(s.CorrelatedExecutionResults[i].Sender, s.CorrelatedExecutionResults[i].CorrelationID))
+		if _, ok := seenExecutionResults[key]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "correlated execution result: %d", i)
+		}
+		seenExecutionResults[key] = struct{}{}
+	}
+	return nil
+}
+
+func (r CorrelatedExecutionResult) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(r.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if r.CorrelationID == "" {
+		return sdkerrors.Wrap(ErrEmpty, "correlation id")
+	}
+	if err := validateCorrelationID(r.CorrelationID); err != nil {
+		return sdkerrors.Wrap(err, "correlation id")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Result.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	return nil
+}
+
+func (m PendingMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkerrors.Wrap(err, "contract address")
+	}
+	if m.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if m.ExecuteAfterHeight <= m.ProposedAtHeight {
+		return sdkerrors.Wrap(ErrInvalid, "execute after height must be after the proposed at height")
+	}
 	return nil
 }
 
@@ -51,19 +134,44 @@ func (c Contract) ValidateBasic() error {
 	if err := c.ContractInfo.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "contract info")
 	}
-
-	if c.ContractInfo.Created != nil {
-		return sdkerrors.Wrap(ErrInvalid, "created must be empty")
-	}
 	for i := range c.ContractState {
 		if err := c.ContractState[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "contract state %d", i)
 		}
 	}
+	for i := range c.ContractCodeHistory {
+		if err := c.ContractCodeHistory[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "contract code history %d", i)
+		}
+	}
+	if n := len(c.ContractCodeHistory); n != 0 && c.ContractCodeHistory[n-1].CodeID != c.ContractInfo.CodeID {
+		return sdkerrors.Wrap(ErrInvalid, "last contract code history entry does not match the contract code id")
+	}
+	if len(c.IndexedEventAttributes) > MaxIndexedEventAttributes {
+		return sdkerrors.Wrapf(ErrLimit, "cannot hold more than %d indexed event attributes", MaxIndexedEventAttributes)
+	}
+	for i := range c.IndexedEventAttributes {
+		if err := c.IndexedEventAttributes[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "indexed event attribute %d", i)
+		}
+	}
 
 	return nil
 }
 
+func (a IndexedEventAttribute) ValidateBasic() error {
+	if a.Key == "" {
+		return sdkerrors.Wrap(ErrEmpty, "key")
+	}
+	if len(a.Key) > MaxIndexedEventAttributeKeySize {
+		return sdkerrors.Wrapf(ErrLimit, "key cannot be longer than %d bytes", MaxIndexedEventAttributeKeySize)
+	}
+	if len(a.Value) > MaxIndexedEventAttributeValueSize {
+		return sdkerrors.Wrapf(ErrLimit, "value cannot be longer than %d bytes", MaxIndexedEventAttributeValueSize)
+	}
+	return nil
+}
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
@@ -26,10 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/wasm
 type GenesisState struct {
-	//    Params params = 1 [(gogoproto.nullable) = false];
-	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
-	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	Params                     Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Codes                      []Code                      `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	Contracts                  []Contract                  `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Sequences                  []Sequence                  `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	ContractSalts              []ContractSalt              `protobuf:"bytes,5,rep,name=contract_salts,json=contractSalts,proto3" json:"contract_salts,omitempty"`
+	PendingMigrations          []PendingMigration          `protobuf:"bytes,6,rep,name=pending_migrations,json=pendingMigrations,proto3" json:"pending_migrations,omitempty"`
+	CorrelatedExecutionResults []CorrelatedExecutionResult `protobuf:"bytes,7,rep,name=correlated_execution_results,json=correlatedExecutionResults,proto3" json:"correlated_execution_results,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -65,6 +68,13 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func (m *GenesisState) GetCodes() []Code {
 	if m != nil {
 		return m.Codes
@@ -86,6 +96,27 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetContractSalts() []ContractSalt {
+	if m != nil {
+		return m.ContractSalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingMigrations() []PendingMigration {
+	if m != nil {
+		return m.PendingMigrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCorrelatedExecutionResults() []CorrelatedExecutionResult {
+	if m != nil {
+		return m.CorrelatedExecutionResults
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -153,6 +184,11 @@ type Contract struct {
 	ContractInfo       ContractInfo                                  `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState      []Model                                       `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCustomInfo *ContractCustomInfo                           `protobuf:"bytes,4,opt,name=contract_custom_info,json=contractCustomInfo,proto3" json:"contract_custom_info,omitempty"`
+	// ContractCodeHistory is the full code history of the contract, including the encrypted
+	// init and migrate messages. Empty for exports that predate code history in genesis.
+	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,5,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+	// IndexedEventAttributes is the event attribute index of the contract, oldest first
+	IndexedEventAttributes []IndexedEventAttribute `protobuf:"bytes,6,rep,name=indexed_event_attributes,json=indexedEventAttributes,proto3" json:"indexed_event_attributes"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -216,6 +252,20 @@ func (m *Contract) GetContractCustomInfo() *ContractCustomInfo {
 	return nil
 }
 
+func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
+	if m != nil {
+		return m.ContractCodeHistory
+	}
+	return nil
+}
+
+func (m *Contract) GetIndexedEventAttributes() []IndexedEventAttribute {
+	if m != nil {
+		return m.IndexedEventAttributes
+	}
+	return nil
+}
+
 // Sequence id and value of a counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -269,11 +319,73 @@ func (m *Sequence) GetValue() uint64 {
 	return 0
 }
 
+// CorrelatedExecutionResult is the result of an execution a sender tagged with a correlation id
+type CorrelatedExecutionResult struct {
+	Sender        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	CorrelationID string                                        `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Result        ContractExecutionResult                       `protobuf:"bytes,3,opt,name=result,proto3" json:"result"`
+}
+
+func (m *CorrelatedExecutionResult) Reset()         { *m = CorrelatedExecutionResult{} }
+func (m *CorrelatedExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*CorrelatedExecutionResult) ProtoMessage()    {}
+func (*CorrelatedExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e737d858048ffc2a, []int{4}
+}
+func (m *CorrelatedExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CorrelatedExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CorrelatedExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CorrelatedExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrelatedExecutionResult.Merge(m, src)
+}
+func (m *CorrelatedExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CorrelatedExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrelatedExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorrelatedExecutionResult proto.InternalMessageInfo
+
+func (m *CorrelatedExecutionResult) GetSender() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Sender
+	}
+	return nil
+}
+
+func (m *CorrelatedExecutionResult) GetCorrelationID() string {
+	if m != nil {
+		return m.CorrelationID
+	}
+	return ""
+}
+
+func (m *CorrelatedExecutionResult) GetResult() ContractExecutionResult {
+	if m != nil {
+		return m.Result
+	}
+	return ContractExecutionResult{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "secret.compute.v1beta1.GenesisState")
 	proto.RegisterType((*Code)(nil), "secret.compute.v1beta1.Code")
 	proto.RegisterType((*Contract)(nil), "secret.compute.v1beta1.Contract")
 	proto.RegisterType((*Sequence)(nil), "secret.compute.v1beta1.Sequence")
+	proto.RegisterType((*CorrelatedExecutionResult)(nil), "secret.compute.v1beta1.CorrelatedExecutionResult")
 }
 
 func init() {
@@ -281,41 +393,60 @@ func init() {
 }
 
 var fileDescriptor_e737d858048ffc2a = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4d, 0x6f, 0x12, 0x41,
-	0x18, 0xc7, 0x59, 0xba, 0x20, 0x1d, 0xd1, 0x9a, 0x91, 0x28, 0xa9, 0x76, 0x21, 0xd8, 0x03, 0x31,
-	0x96, 0x0d, 0x7a, 0xf3, 0xd6, 0xa5, 0x89, 0x41, 0xa2, 0x26, 0x8b, 0x27, 0x6d, 0x42, 0x96, 0x99,
-	0xa7, 0xb8, 0x01, 0x76, 0x70, 0x67, 0xa8, 0xee, 0xa7, 0xd0, 0x8b, 0xdf, 0xa9, 0xc7, 0x1e, 0x3d,
-	0x11, 0xb3, 0xdc, 0xfc, 0x08, 0x9e, 0xcc, 0xbc, 0xb0, 0xdd, 0x44, 0x69, 0x4f, 0xb0, 0xcf, 0xfc,
-	0x9f, 0xdf, 0xfc, 0x9f, 0x97, 0x41, 0x87, 0x1c, 0x48, 0x0c, 0xc2, 0x25, 0x6c, 0xbe, 0x58, 0x0a,
-	0x70, 0xcf, 0xbb, 0x63, 0x10, 0x41, 0xd7, 0x9d, 0x40, 0x04, 0x3c, 0xe4, 0x9d, 0x45, 0xcc, 0x04,
-	0xc3, 0x0f, 0xb4, 0xaa, 0x63, 0x54, 0x1d, 0xa3, 0xda, 0xaf, 0x4d, 0xd8, 0x84, 0x29, 0x89, 0x2b,
-	0xff, 0x69, 0xf5, 0x7e, 0x6b, 0x0b, 0x53, 0x24, 0x0b, 0x30, 0xc4, 0xd6, 0x8f, 0x22, 0xaa, 0xbe,
-	0xd2, 0x77, 0x0c, 0x45, 0x20, 0x00, 0x0f, 0x50, 0x89, 0x30, 0x0a, 0xbc, 0x5e, 0x6c, 0xee, 0xb4,
-	0x6f, 0x3f, 0x7f, 0xdc, 0xf9, 0xff, 0x95, 0x9d, 0x1e, 0xa3, 0xe0, 0x3d, 0xbc, 0x58, 0x35, 0x0a,
-	0xbf, 0x57, 0x8d, 0x3d, 0x95, 0xf2, 0x8c, 0xcd, 0x43, 0x01, 0xf3, 0x85, 0x48, 0x7c, 0xcd, 0xc0,
-	0x1f, 0xd1, 0x2e, 0x61, 0x91, 0x88, 0x03, 0x22, 0x78, 0x7d, 0x47, 0x01, 0x9b, 0xdb, 0x81, 0x5a,
-	0xe8, 0x3d, 0x32, 0xd0, 0xfb, 0x59, 0x6a, 0x0e, 0x7c, 0xc5, 0x93, 0x70, 0x0e, 0x9f, 0x97, 0x10,
-	0x11, 0xe0, 0x75, 0xfb, 0x7a, 0xf8, 0xd0, 0x08, 0xaf, 0xe0, 0x59, 0x6a, 0x1e, 0x9e, 0x05, 0x5b,
-	0xdf, 0x2c, 0x64, 0xcb, 0x12, 0xf1, 0x13, 0x74, 0x4b, 0xd6, 0x32, 0x0a, 0x69, 0xdd, 0x6a, 0x5a,
-	0x6d, 0xdb, 0x43, 0xe9, 0xaa, 0x51, 0x96, 0x47, 0xfd, 0x13, 0xbf, 0x2c, 0x8f, 0xfa, 0x14, 0xf7,
-	0x64, 0x9d, 0x52, 0x14, 0x9d, 0xb1, 0x7a, 0xb1, 0x69, 0x5d, 0x5f, 0x27, 0x85, 0x7e, 0x74, 0xc6,
-	0x3c, 0x5b, 0x5a, 0xf1, 0x2b, 0xc4, 0x7c, 0xe3, 0x03, 0x84, 0x14, 0x64, 0x9c, 0x08, 0x90, 0xdd,
-	0xb2, 0xda, 0x55, 0x5f, 0x61, 0x3d, 0x19, 0x68, 0xad, 0x8b, 0xa8, 0xb2, 0xe9, 0x11, 0x3e, 0x45,
-	0xf7, 0x36, 0x8d, 0x18, 0x05, 0x94, 0xc6, 0xc0, 0xb9, 0xb2, 0x57, 0xf5, 0xba, 0x7f, 0x56, 0x8d,
-	0xa3, 0x49, 0x28, 0x3e, 0x2d, 0xc7, 0xf2, 0x6a, 0x97, 0x30, 0x3e, 0x67, 0xdc, 0xfc, 0x1c, 0x71,
-	0x3a, 0x35, 0xe3, 0x3f, 0x26, 0xe4, 0x58, 0x27, 0xfa, 0x7b, 0x1b, 0x94, 0x09, 0xe0, 0x77, 0xe8,
-	0x4e, 0x46, 0xcf, 0x95, 0x74, 0x78, 0xd3, 0xe8, 0x72, 0x65, 0x55, 0x49, 0x2e, 0x86, 0x5f, 0xa3,
-	0xbb, 0x19, 0x90, 0xcb, 0x35, 0x33, 0xcb, 0x70, 0xb0, 0x8d, 0xf8, 0x86, 0x51, 0x98, 0x19, 0x54,
-	0xe6, 0x45, 0x2f, 0xe8, 0x29, 0xaa, 0x65, 0x2c, 0xb2, 0xe4, 0x82, 0xcd, 0xb5, 0x47, 0x5b, 0x79,
-	0x7c, 0x7a, 0x93, 0xc7, 0x9e, 0x4a, 0x91, 0xae, 0x7c, 0x4c, 0xfe, 0x89, 0xb5, 0x3c, 0x54, 0xd9,
-	0xec, 0x0a, 0x6e, 0xa2, 0x72, 0x48, 0x47, 0x53, 0x48, 0x4c, 0x6b, 0x77, 0xd3, 0x55, 0xa3, 0xd4,
-	0x3f, 0x19, 0x40, 0xe2, 0x97, 0x42, 0x3a, 0x80, 0x04, 0xd7, 0x50, 0xe9, 0x3c, 0x98, 0x2d, 0x41,
-	0x35, 0xc8, 0xf6, 0xf5, 0x87, 0xf7, 0xfe, 0x22, 0x75, 0xac, 0xcb, 0xd4, 0xb1, 0x7e, 0xa5, 0x8e,
-	0xf5, 0x7d, 0xed, 0x14, 0x2e, 0xd7, 0x4e, 0xe1, 0xe7, 0xda, 0x29, 0x7c, 0x78, 0x99, 0x1b, 0x0c,
-	0x27, 0xb1, 0x98, 0x05, 0x63, 0xee, 0x0e, 0x95, 0xe1, 0xb7, 0x20, 0xbe, 0xb0, 0x78, 0xea, 0x7e,
-	0xcd, 0x9e, 0x6b, 0x18, 0x09, 0x88, 0xa3, 0x60, 0xa6, 0x07, 0x36, 0x2e, 0xab, 0x07, 0xfb, 0xe2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xfb, 0xbf, 0x15, 0x2a, 0x04, 0x00, 0x00,
+	// 835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x77, 0x13, 0x6f, 0x3b, 0xed, 0xb2, 0xec, 0x50, 0x16, 0x53, 0xba, 0x49, 0x14, 0x2a,
+	0x54, 0x21, 0x1a, 0xd3, 0x72, 0x41, 0x88, 0x4b, 0x9d, 0x56, 0x10, 0xaa, 0x02, 0x72, 0x38, 0xc1,
+	0x4a, 0x91, 0x33, 0xf3, 0x36, 0x6b, 0x1a, 0x7b, 0xc2, 0xcc, 0xb8, 0xd4, 0x07, 0xfe, 0x06, 0xf8,
+	0x1f, 0xf8, 0x3f, 0x38, 0xef, 0x71, 0x8f, 0x9c, 0x22, 0x94, 0x1e, 0x90, 0xb8, 0x70, 0xe7, 0x84,
+	0xe6, 0x87, 0x1d, 0xb3, 0x5b, 0x37, 0x12, 0xa7, 0xc4, 0xf3, 0xde, 0xf7, 0x7d, 0xef, 0xe9, 0x7d,
+	0x6f, 0x06, 0xed, 0x09, 0x20, 0x1c, 0xa4, 0x4f, 0x58, 0x32, 0xcb, 0x24, 0xf8, 0x97, 0x87, 0x63,
+	0x90, 0xd1, 0xa1, 0x3f, 0x81, 0x14, 0x44, 0x2c, 0x7a, 0x33, 0xce, 0x24, 0xc3, 0x8f, 0x4c, 0x56,
+	0xcf, 0x66, 0xf5, 0x6c, 0xd6, 0xce, 0xf6, 0x84, 0x4d, 0x98, 0x4e, 0xf1, 0xd5, 0x3f, 0x93, 0xbd,
+	0xd3, 0xad, 0xe1, 0x94, 0xf9, 0x0c, 0x2c, 0x63, 0xf7, 0xcf, 0x26, 0xda, 0xfa, 0xcc, 0x68, 0x0c,
+	0x65, 0x24, 0x01, 0x7f, 0x8a, 0xdc, 0x59, 0xc4, 0xa3, 0x44, 0x78, 0x4e, 0xc7, 0xd9, 0xdf, 0x3c,
+	0x6a, 0xf5, 0x6e, 0xd6, 0xec, 0x7d, 0xad, 0xb3, 0x82, 0xc6, 0xf3, 0x79, 0x7b, 0x2d, 0xb4, 0x18,
+	0x7c, 0x86, 0x9a, 0x84, 0x51, 0x10, 0xde, 0x9d, 0xce, 0xdd, 0xfd, 0xcd, 0xa3, 0xdd, 0x3a, 0x70,
+	0x9f, 0x51, 0x08, 0xde, 0x52, 0xd0, 0xbf, 0xe6, 0xed, 0x07, 0x1a, 0xf2, 0x01, 0x4b, 0x62, 0x09,
+	0xc9, 0x4c, 0xe6, 0xa1, 0xe1, 0xc0, 0xdf, 0xa1, 0x0d, 0xc2, 0x52, 0xc9, 0x23, 0x22, 0x85, 0x77,
+	0x57, 0x13, 0x76, 0xea, 0x09, 0x4d, 0x62, 0xf0, 0x8e, 0x25, 0x7d, 0xa3, 0x84, 0x56, 0x88, 0x97,
+	0x7c, 0x8a, 0x5c, 0xc0, 0x0f, 0x19, 0xa4, 0x04, 0x84, 0xd7, 0xb8, 0x9d, 0x7c, 0x68, 0x13, 0x97,
+	0xe4, 0x25, 0xb4, 0x4a, 0x5e, 0x1e, 0xe2, 0x04, 0xbd, 0x56, 0x28, 0x8d, 0x44, 0x34, 0x95, 0xc2,
+	0x6b, 0x6a, 0x85, 0xbd, 0x55, 0xe5, 0x0f, 0xa3, 0xa9, 0x0c, 0x3a, 0x56, 0xc5, 0xfb, 0x2f, 0x47,
+	0x45, 0xea, 0x3e, 0xa9, 0xe4, 0x0b, 0xfc, 0x13, 0xc2, 0x33, 0x48, 0x69, 0x9c, 0x4e, 0x46, 0x49,
+	0x3c, 0xe1, 0x91, 0x8c, 0x59, 0x2a, 0x3c, 0x57, 0x4b, 0xee, 0xd7, 0xce, 0xcf, 0x20, 0xce, 0x0b,
+	0x40, 0xb0, 0x67, 0x65, 0x77, 0x5f, 0xe5, 0xaa, 0x48, 0x3f, 0x9c, 0xbd, 0x84, 0x13, 0xf8, 0x57,
+	0x07, 0xed, 0x12, 0xc6, 0x39, 0x4c, 0x23, 0x09, 0x74, 0x04, 0x57, 0x40, 0x32, 0x15, 0x19, 0x71,
+	0x10, 0x99, 0x6a, 0xfe, 0x9e, 0xae, 0xe4, 0xb0, 0xbe, 0xf9, 0x02, 0x7b, 0x5a, 0x40, 0x43, 0x8d,
+	0x0c, 0x7a, 0xb6, 0xa4, 0xf7, 0x6e, 0xa3, 0xaf, 0x14, 0xb7, 0x43, 0xea, 0xa8, 0x44, 0xf7, 0x67,
+	0x07, 0x35, 0x94, 0xed, 0xf0, 0xbb, 0xe8, 0x9e, 0xf2, 0xd7, 0x28, 0xa6, 0xda, 0xe2, 0x8d, 0x00,
+	0x2d, 0xe6, 0x6d, 0x57, 0x85, 0x06, 0x27, 0xa1, 0xab, 0x42, 0x03, 0x8a, 0xfb, 0xca, 0x7b, 0x2a,
+	0x29, 0x7d, 0xca, 0xbc, 0x3b, 0x7a, 0x13, 0x3a, 0xb7, 0x99, 0x79, 0x90, 0x3e, 0x65, 0x76, 0x17,
+	0xd6, 0x89, 0xfd, 0xc6, 0x8f, 0x11, 0xd2, 0x24, 0xe3, 0x5c, 0x82, 0x72, 0xb0, 0xb3, 0xbf, 0x15,
+	0x6a, 0xda, 0x40, 0x1d, 0x74, 0x7f, 0x6b, 0xa0, 0xf5, 0x62, 0xf0, 0xf8, 0x09, 0x7a, 0xbd, 0x1c,
+	0x77, 0x44, 0x29, 0x07, 0x61, 0x36, 0x70, 0x2b, 0x38, 0xfc, 0x67, 0xde, 0x3e, 0x98, 0xc4, 0xf2,
+	0x59, 0x36, 0x56, 0xd2, 0x3e, 0x61, 0x22, 0x61, 0xc2, 0xfe, 0x1c, 0x08, 0x7a, 0x61, 0x17, 0xfa,
+	0x98, 0x90, 0x63, 0x03, 0x0c, 0x1f, 0x14, 0x54, 0xf6, 0x00, 0x7f, 0x85, 0x4a, 0xcb, 0x54, 0x5b,
+	0x5a, 0xe9, 0xc7, 0x4a, 0x5b, 0x5b, 0xa4, 0x72, 0x86, 0xbf, 0xa8, 0x3a, 0x5c, 0x5d, 0x1c, 0x76,
+	0x41, 0x1f, 0xd7, 0x31, 0x9e, 0x33, 0x0a, 0x53, 0x4b, 0xb5, 0xb4, 0xaf, 0xbe, 0x72, 0x9e, 0xa0,
+	0xed, 0x92, 0x8b, 0x64, 0x42, 0xb2, 0xc4, 0xd4, 0xd8, 0xd0, 0x35, 0xbe, 0xbf, 0xaa, 0xc6, 0xbe,
+	0x86, 0xa8, 0xaa, 0x42, 0x4c, 0x5e, 0x39, 0xc3, 0xdf, 0xa3, 0x37, 0x97, 0xec, 0x6a, 0x1a, 0xcf,
+	0x62, 0x21, 0x19, 0xcf, 0xed, 0x4a, 0x7e, 0xb8, 0x92, 0x9e, 0x51, 0xf8, 0xdc, 0x40, 0x4e, 0x53,
+	0xc9, 0x73, 0xdb, 0x43, 0x79, 0xbf, 0x54, 0xe2, 0x38, 0x41, 0x5e, 0x9c, 0x52, 0xb8, 0x52, 0x36,
+	0xbd, 0x84, 0x54, 0x8e, 0x22, 0x29, 0x79, 0x3c, 0xce, 0xd4, 0xf8, 0xcd, 0x3a, 0x1e, 0xd4, 0xc9,
+	0x0d, 0x0c, 0xee, 0x54, 0xc1, 0x8e, 0x0b, 0x94, 0xd5, 0x7a, 0x14, 0xdf, 0x14, 0x14, 0xdd, 0x00,
+	0xad, 0x17, 0x57, 0x13, 0xee, 0x20, 0x37, 0xa6, 0xa3, 0x0b, 0xc8, 0xad, 0x6b, 0x36, 0x16, 0xf3,
+	0x76, 0x73, 0x70, 0x72, 0x06, 0x79, 0xd8, 0x8c, 0xe9, 0x19, 0xe4, 0x78, 0x1b, 0x35, 0x2f, 0xa3,
+	0x69, 0x06, 0x7a, 0xf6, 0x8d, 0xd0, 0x7c, 0x74, 0xff, 0x76, 0xd0, 0xdb, 0xb5, 0x0b, 0x88, 0x07,
+	0xc8, 0x15, 0x90, 0x52, 0xe0, 0xff, 0xdf, 0x8b, 0x96, 0x00, 0x7f, 0xac, 0x1c, 0x63, 0x74, 0xd4,
+	0xf2, 0xc6, 0x54, 0xd7, 0xb1, 0x11, 0x3c, 0x5c, 0xcc, 0xdb, 0xf7, 0xfb, 0xcb, 0xc8, 0xe0, 0x44,
+	0xf9, 0x63, 0xf9, 0x49, 0xf1, 0x39, 0x72, 0xcd, 0xaa, 0xeb, 0x15, 0xda, 0x3c, 0xf2, 0x57, 0x8d,
+	0xec, 0xe5, 0x6b, 0xc4, 0xbe, 0x51, 0x86, 0x24, 0xf8, 0xe6, 0xf9, 0xa2, 0xe5, 0xbc, 0x58, 0xb4,
+	0x9c, 0x3f, 0x16, 0x2d, 0xe7, 0x97, 0xeb, 0xd6, 0xda, 0x8b, 0xeb, 0xd6, 0xda, 0xef, 0xd7, 0xad,
+	0xb5, 0x6f, 0x3f, 0xa9, 0x74, 0x26, 0x08, 0x97, 0xd3, 0x68, 0x2c, 0xfc, 0xa1, 0xd6, 0xfa, 0x12,
+	0xe4, 0x8f, 0x8c, 0x5f, 0xf8, 0x57, 0xe5, 0x6b, 0x1a, 0xa7, 0x12, 0x78, 0x1a, 0x4d, 0x4d, 0xc7,
+	0x63, 0x57, 0xbf, 0xa7, 0x1f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x1e, 0xb1, 0x15, 0xee, 0xc9,
+	0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -338,6 +469,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CorrelatedExecutionResults) > 0 {
+		for iNdEx := len(m.CorrelatedExecutionResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CorrelatedExecutionResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingMigrations) > 0 {
+		for iNdEx := len(m.PendingMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ContractSalts) > 0 {
+		for iNdEx := len(m.ContractSalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -380,6 +553,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -448,6 +631,34 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IndexedEventAttributes) > 0 {
+		for iNdEx := len(m.IndexedEventAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IndexedEventAttributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ContractCodeHistory) > 0 {
+		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCodeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.ContractCustomInfo != nil {
 		{
 			size, err := m.ContractCustomInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -529,6 +740,53 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CorrelatedExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorrelatedExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorrelatedExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CorrelationID) > 0 {
+		i -= len(m.CorrelationID)
+		copy(dAtA[i:], m.CorrelationID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CorrelationID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -546,6 +804,8 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	if len(m.Codes) > 0 {
 		for _, e := range m.Codes {
 			l = e.Size()
@@ -564,6 +824,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractSalts) > 0 {
+		for _, e := range m.ContractSalts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingMigrations) > 0 {
+		for _, e := range m.PendingMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CorrelatedExecutionResults) > 0 {
+		for _, e := range m.CorrelatedExecutionResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -607,6 +885,18 @@ func (m *Contract) Size() (n int) {
 		l = m.ContractCustomInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ContractCodeHistory) > 0 {
+		for _, e := range m.ContractCodeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IndexedEventAttributes) > 0 {
+		for _, e := range m.IndexedEventAttributes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -626,6 +916,25 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *CorrelatedExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CorrelationID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -661,6 +970,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
@@ -763,6 +1105,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSalts = append(m.ContractSalts, ContractSalt{})
+			if err := m.ContractSalts[len(m.ContractSalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingMigrations = append(m.PendingMigrations, PendingMigration{})
+			if err := m.PendingMigrations[len(m.PendingMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelatedExecutionResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelatedExecutionResults = append(m.CorrelatedExecutionResults, CorrelatedExecutionResult{})
+			if err := m.CorrelatedExecutionResults[len(m.CorrelatedExecutionResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1086,6 +1530,74 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCodeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCodeHistory = append(m.ContractCodeHistory, ContractCodeHistoryEntry{})
+			if err := m.ContractCodeHistory[len(m.ContractCodeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedEventAttributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedEventAttributes = append(m.IndexedEventAttributes, IndexedEventAttribute{})
+			if err := m.IndexedEventAttributes[len(m.IndexedEventAttributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1210,6 +1722,155 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CorrelatedExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CorrelatedExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CorrelatedExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
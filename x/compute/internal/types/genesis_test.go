@@ -2,8 +2,10 @@ package types
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,14 +17,61 @@ func TestValidateGenesisState(t *testing.T) {
 		"all good": {
 			srcMutator: func(s *GenesisState) {},
 		},
-		/*
-			"params invalid": {
-				srcMutator: func(s *GenesisState) {
-					s.Params = Params{}
-				},
-				expError: true,
+		"params invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.Params = Params{}
+			},
+			expError: true,
+		},
+		"params msg size above max": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.MaxContractMsgSize = MaxContractMsgSize + 1
+			},
+			expError: true,
+		},
+		"params gas multiplier below one": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.ComputeGasMultiplier = 0
+			},
+			expError: true,
+		},
+		"params ibc receiver whitelist invalid address": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.IbcReceiverWhitelist = []string{"invalid"}
+			},
+			expError: true,
+		},
+		"params ibc receiver whitelist duplicate": {
+			srcMutator: func(s *GenesisState) {
+				addr := sdk.AccAddress(make([]byte, 20)).String()
+				s.Params.IbcReceiverWhitelist = []string{addr, addr}
+			},
+			expError: true,
+		},
+		"params instantiate limit exempt invalid address": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.InstantiateLimitExempt = []string{"invalid"}
 			},
-		*/
+			expError: true,
+		},
+		"params instantiate limit exempt duplicate": {
+			srcMutator: func(s *GenesisState) {
+				addr := sdk.AccAddress(make([]byte, 20)).String()
+				s.Params.InstantiateLimitExempt = []string{addr, addr}
+			},
+			expError: true,
+		},
+		"params storage deletion refund percent": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.StorageDeletionRefundPercent = 100
+			},
+		},
+		"params storage deletion refund percent above 100": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.StorageDeletionRefundPercent = 101
+			},
+			expError: true,
+		},
 		"codeinfo invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.Codes[0].CodeInfo.CodeHash = nil
@@ -41,6 +90,90 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"contract salt invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractSalts = []ContractSalt{{
+					CodeID:          1,
+					Creator:         make([]byte, 20),
+					ContractAddress: s.Contracts[0].ContractAddress,
+				}}
+			},
+			expError: true,
+		},
+		"contract salt duplicate": {
+			srcMutator: func(s *GenesisState) {
+				salt := ContractSalt{
+					CodeID:          1,
+					Creator:         make([]byte, 20),
+					Salt:            []byte("salt"),
+					ContractAddress: s.Contracts[0].ContractAddress,
+				}
+				s.ContractSalts = []ContractSalt{salt, salt}
+			},
+			expError: true,
+		},
+		"pending migration valid": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingMigrations = []PendingMigration{{
+					ContractAddress:    s.Contracts[0].ContractAddress.String(),
+					CodeID:             1,
+					ProposedAtHeight:   10,
+					ExecuteAfterHeight: 20,
+				}}
+			},
+		},
+		"pending migration invalid window": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingMigrations = []PendingMigration{{
+					ContractAddress:    s.Contracts[0].ContractAddress.String(),
+					CodeID:             1,
+					ProposedAtHeight:   10,
+					ExecuteAfterHeight: 10,
+				}}
+			},
+			expError: true,
+		},
+		"pending migration duplicate": {
+			srcMutator: func(s *GenesisState) {
+				pending := PendingMigration{
+					ContractAddress:    s.Contracts[0].ContractAddress.String(),
+					CodeID:             1,
+					ProposedAtHeight:   10,
+					ExecuteAfterHeight: 20,
+				}
+				s.PendingMigrations = []PendingMigration{pending, pending}
+			},
+			expError: true,
+		},
+		"correlated execution result valid": {
+			srcMutator: func(s *GenesisState) {
+				s.CorrelatedExecutionResults = []CorrelatedExecutionResult{{
+					Sender:        make([]byte, 20),
+					CorrelationID: "order-1",
+					Result:        ContractExecutionResult{ContractAddress: s.Contracts[0].ContractAddress.String(), Height: 10},
+				}}
+			},
+		},
+		"correlated execution result without correlation id": {
+			srcMutator: func(s *GenesisState) {
+				s.CorrelatedExecutionResults = []CorrelatedExecutionResult{{
+					Sender: make([]byte, 20),
+					Result: ContractExecutionResult{ContractAddress: s.Contracts[0].ContractAddress.String(), Height: 10},
+				}}
+			},
+			expError: true,
+		},
+		"correlated execution result duplicate": {
+			srcMutator: func(s *GenesisState) {
+				correlated := CorrelatedExecutionResult{
+					Sender:        make([]byte, 20),
+					CorrelationID: "order-1",
+					Result:        ContractExecutionResult{ContractAddress: s.Contracts[0].ContractAddress.String(), Height: 10},
+				}
+				s.CorrelatedExecutionResults = []CorrelatedExecutionResult{correlated, correlated}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -125,9 +258,8 @@ func TestContractValidateBasic(t *testing.T) {
 		},
 		"contract with created set": {
 			srcMutator: func(c *Contract) {
-				c.ContractInfo.Created = &AbsoluteTxPosition{}
+				c.ContractInfo.Created = &AbsoluteTxPosition{BlockHeight: 3, TxIndex: 1}
 			},
-			expError: true,
 		},
 		"contract state invalid": {
 			srcMutator: func(c *Contract) {
@@ -135,6 +267,39 @@ func TestContractValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"contract code history": {
+			srcMutator: func(c *Contract) {
+				c.ContractCodeHistory = []ContractCodeHistoryEntry{
+					{Operation: ContractCodeHistoryOperationTypeInit, CodeID: 2, Updated: &AbsoluteTxPosition{BlockHeight: 1}, Msg: []byte("encrypted init")},
+					{Operation: ContractCodeHistoryOperationTypeMigrate, CodeID: 1, Updated: &AbsoluteTxPosition{BlockHeight: 2}, Msg: []byte("encrypted migrate")},
+				}
+			},
+		},
+		"contract code history entry invalid": {
+			srcMutator: func(c *Contract) {
+				c.ContractCodeHistory = []ContractCodeHistoryEntry{{Operation: ContractCodeHistoryOperationTypeInit, CodeID: 1}}
+			},
+			expError: true,
+		},
+		"contract code history not ending at the contract code id": {
+			srcMutator: func(c *Contract) {
+				c.ContractCodeHistory = []ContractCodeHistoryEntry{
+					{Operation: ContractCodeHistoryOperationTypeInit, CodeID: 2, Updated: &AbsoluteTxPosition{BlockHeight: 1}},
+				}
+			},
+			expError: true,
+		},
+		"indexed event attributes": {
+			srcMutator: func(c *Contract) {
+				c.IndexedEventAttributes = []IndexedEventAttribute{{EventType: "wasm", Key: "order", Value: "1", Height: 1}}
+			},
+		},
+		"indexed event attribute value too long": {
+			srcMutator: func(c *Contract) {
+				c.IndexedEventAttributes = []IndexedEventAttribute{{EventType: "wasm", Key: "order", Value: strings.Repeat("x", MaxIndexedEventAttributeValueSize+1), Height: 1}}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -33,10 +33,22 @@ var (
 	TXCounterPrefix                                = []byte{0x08}
 	ContractCodeHistoryElementPrefix               = []byte{0x09}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x0A}
+	ContractStorageUsagePrefix                     = []byte{0x0B}
+	ContractSaltPrefix                             = []byte{0x0C}
+	ExecutionResultByCorrelationIDPrefix           = []byte{0x0D}
+	IndexedEventAttributePrefix                    = []byte{0x0F}
+	IndexedEventAttributeCountPrefix               = []byte{0x10}
+	InstantiateCountPrefix                         = []byte{0x11}
+	PendingMigrationPrefix                         = []byte{0x12}
+	ContractsByCreatorPrefix                       = []byte{0x13}
+	ExecutionResultByHeightPrefix                  = []byte{0x14}
+	IndexedEventAttributeBySeqPrefix               = []byte{0x15}
 	RandomPrefix                                   = []byte{0xFF}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyCodesCount     = append(SequenceKeyPrefix, []byte("codesCount")...)
+	KeyContractsCount = append(SequenceKeyPrefix, []byte("contractsCount")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -71,6 +83,99 @@ func GetContractStorePrefixKey(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
 }
 
+// GetContractStorageUsageKey returns the key for the number of bytes a contract holds in its state
+func GetContractStorageUsageKey(addr sdk.AccAddress) []byte {
+	return append(ContractStorageUsagePrefix, addr...)
+}
+
+// GetInstantiateCountKey returns the key of the number of contracts sender instantiated in the current block
+func GetInstantiateCountKey(sender sdk.AccAddress) []byte {
+	return append(InstantiateCountPrefix, sender...)
+}
+
+// GetPendingMigrationKey returns the key of the pending migration of a contract
+func GetPendingMigrationKey(contractAddr sdk.AccAddress) []byte {
+	return append(PendingMigrationPrefix, contractAddr...)
+}
+
+// GetContractSaltKey returns the key under which a salt used by a creator for a code is recorded:
+// `<prefix><codeID><creatorAddrLen (1 Byte)><creatorAddr><salt>`
+func GetContractSaltKey(codeID uint64, creator sdk.AccAddress, salt []byte) []byte {
+	prefixLen := len(ContractSaltPrefix)
+	r := make([]byte, prefixLen+8+1+len(creator)+len(salt))
+	copy(r, ContractSaltPrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(codeID))
+	r[prefixLen+8] = byte(len(creator))
+	copy(r[prefixLen+8+1:], creator)
+	copy(r[prefixLen+8+1+len(creator):], salt)
+	return r
+}
+
+// GetExecutionResultByCorrelationIDKey returns the key under which the result of an execution a
+// sender tagged with correlationID is recorded: `<prefix><senderAddrLen (1 Byte)><senderAddr><correlationID>`
+func GetExecutionResultByCorrelationIDKey(sender sdk.AccAddress, correlationID string) []byte {
+	prefixLen := len(ExecutionResultByCorrelationIDPrefix)
+	r := make([]byte, prefixLen+1+len(sender)+len(correlationID))
+	copy(r, ExecutionResultByCorrelationIDPrefix)
+	r[prefixLen] = byte(len(sender))
+	copy(r[prefixLen+1:], sender)
+	copy(r[prefixLen+1+len(sender):], correlationID)
+	return r
+}
+
+// GetExecutionResultByHeightKey returns the key under which the result of an execution a sender
+// tagged with correlationID is indexed by the height it was recorded at, so it can be pruned:
+// `<prefix><height><senderAddrLen (1 Byte)><senderAddr><correlationID>`
+func GetExecutionResultByHeightKey(height int64, sender sdk.AccAddress, correlationID string) []byte {
+	return append(GetExecutionResultByHeightPrefix(height), GetExecutionResultByCorrelationIDKey(sender, correlationID)[len(ExecutionResultByCorrelationIDPrefix):]...)
+}
+
+// GetExecutionResultByHeightPrefix returns the prefix of the execution results recorded at height
+func GetExecutionResultByHeightPrefix(height int64) []byte {
+	return append(append([]byte{}, ExecutionResultByHeightPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetIndexedEventAttributePrefix returns the prefix of the indexed event attributes of a contract
+// with a key: `<prefix><contractAddrLen (1 Byte)><contractAddr><keyLen (1 Byte)><key>`
+func GetIndexedEventAttributePrefix(contractAddr sdk.AccAddress, key string) []byte {
+	prefixLen := len(IndexedEventAttributePrefix)
+	r := make([]byte, prefixLen+1+len(contractAddr)+1+len(key))
+	copy(r, IndexedEventAttributePrefix)
+	r[prefixLen] = byte(len(contractAddr))
+	copy(r[prefixLen+1:], contractAddr)
+	r[prefixLen+1+len(contractAddr)] = byte(len(key))
+	copy(r[prefixLen+1+len(contractAddr)+1:], key)
+	return r
+}
+
+// GetIndexedEventAttributeKey returns the key of the seq-th indexed event attribute of a contract:
+// `<prefix><contractAddrLen (1 Byte)><contractAddr><keyLen (1 Byte)><key><seq>`
+func GetIndexedEventAttributeKey(contractAddr sdk.AccAddress, key string, seq uint64) []byte {
+	return append(GetIndexedEventAttributePrefix(contractAddr, key), sdk.Uint64ToBigEndian(seq)...)
+}
+
+// GetIndexedEventAttributeCountKey returns the key for the number of event attributes indexed for a contract
+func GetIndexedEventAttributeCountKey(contractAddr sdk.AccAddress) []byte {
+	return append(IndexedEventAttributeCountPrefix, contractAddr...)
+}
+
+// GetIndexedEventAttributeBySeqPrefix returns the prefix under which the keys of the indexed event
+// attributes of a contract are recorded in the order they were indexed: `<prefix><contractAddrLen (1 Byte)><contractAddr>`
+func GetIndexedEventAttributeBySeqPrefix(contractAddr sdk.AccAddress) []byte {
+	prefixLen := len(IndexedEventAttributeBySeqPrefix)
+	r := make([]byte, prefixLen+1+len(contractAddr))
+	copy(r, IndexedEventAttributeBySeqPrefix)
+	r[prefixLen] = byte(len(contractAddr))
+	copy(r[prefixLen+1:], contractAddr)
+	return r
+}
+
+// GetIndexedEventAttributeBySeqKey returns the key under which the key of the seq-th indexed event
+// attribute of a contract is recorded: `<prefix><contractAddrLen (1 Byte)><contractAddr><seq>`
+func GetIndexedEventAttributeBySeqKey(contractAddr sdk.AccAddress, seq uint64) []byte {
+	return append(GetIndexedEventAttributeBySeqPrefix(contractAddr), sdk.Uint64ToBigEndian(seq)...)
+}
+
 // GetContractStorePrefixKey returns the store prefix for the WASM contract instance
 func GetContractLabelPrefix(addr string) []byte {
 	return append(ContractLabelPrefix, []byte(addr)...)
@@ -109,6 +214,29 @@ func GetContractByCodeIDSecondaryIndexPrefix(codeID uint64) []byte {
 	return r
 }
 
+// GetContractsByCreatorPrefix returns the prefix of the contracts a creator instantiated:
+// `<prefix><creatorAddrLen (1 Byte)><creatorAddr>`
+func GetContractsByCreatorPrefix(creator sdk.AccAddress) []byte {
+	prefixLen := len(ContractsByCreatorPrefix)
+	r := make([]byte, prefixLen+1+len(creator))
+	copy(r, ContractsByCreatorPrefix)
+	r[prefixLen] = byte(len(creator))
+	copy(r[prefixLen+1:], creator)
+	return r
+}
+
+// GetContractByCreatorSecondaryIndexKey returns the key for the contracts-by-creator index:
+// `<prefix><creatorAddrLen (1 Byte)><creatorAddr><created><contractAddr>`
+func GetContractByCreatorSecondaryIndexKey(creator sdk.AccAddress, created *AbsoluteTxPosition, contractAddr sdk.AccAddress) []byte {
+	prefix := GetContractsByCreatorPrefix(creator)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+AbsoluteTxPositionLen+len(contractAddr))
+	copy(r, prefix)
+	copy(r[prefixLen:], created.Bytes())
+	copy(r[prefixLen+AbsoluteTxPositionLen:], contractAddr)
+	return r
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
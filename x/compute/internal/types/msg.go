@@ -32,6 +32,16 @@ func (msg MsgStoreCode) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "builder %s", err.Error())
 	}
 
+	if err := validateSourceChecksum(msg.SourceChecksum, msg.Source); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "source checksum %s", err.Error())
+	}
+
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
 	return nil
 }
 
@@ -64,6 +74,18 @@ func (msg MsgInstantiateContract) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateSalt(msg.Salt); err != nil {
+		return sdkerrors.Wrap(err, "salt")
+	}
+
+	if err := validateSendWhitelist(msg.SendWhitelist); err != nil {
+		return sdkerrors.Wrap(err, "send whitelist")
+	}
+
+	if err := ValidateContractMsgSize(msg.InitMsg, MaxContractMsgSize); err != nil {
+		return sdkerrors.Wrap(err, "init msg")
+	}
+
 	if !msg.InitFunds.IsValid() {
 		return sdkerrors.ErrInvalidCoins
 	}
@@ -95,10 +117,27 @@ func (msg MsgExecuteContract) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateContractMsgSize(msg.Msg, MaxContractMsgSize); err != nil {
+		return sdkerrors.Wrap(err, "msg")
+	}
+
 	if !msg.SentFunds.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "sentFunds")
 	}
 
+	if err := validateCorrelationID(msg.CorrelationID); err != nil {
+		return sdkerrors.Wrap(err, "correlation id")
+	}
+
+	if msg.ReadOnly {
+		if !msg.SentFunds.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "read-only executions can't send funds")
+		}
+		if msg.CorrelationID != "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "read-only executions can't record a correlation id")
+		}
+	}
+
 	return nil
 }
 
@@ -170,6 +209,9 @@ func (msg MsgUpdateAdmin) ValidateBasic() error {
 	if strings.EqualFold(msg.Sender, msg.NewAdmin) {
 		return sdkerrors.Wrap(ErrInvalidMsg, "new admin is the same as the old")
 	}
+	if strings.EqualFold(msg.Contract, msg.NewAdmin) {
+		return sdkerrors.Wrap(ErrInvalidMsg, "new admin cannot be the contract itself")
+	}
 	return nil
 }
 
@@ -214,3 +256,160 @@ func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{senderAddr}
 }
+
+func (msg MsgPauseContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgPauseContract) Type() string {
+	return "pause-contract"
+}
+
+func (msg MsgPauseContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgPauseContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPauseContract) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgResumeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgResumeContract) Type() string {
+	return "resume-contract"
+}
+
+func (msg MsgResumeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgResumeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgResumeContract) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgTerminateContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgTerminateContract) Type() string {
+	return "terminate-contract"
+}
+
+func (msg MsgTerminateContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	return nil
+}
+
+func (msg MsgTerminateContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the contract, so the message can only be dispatched by the contract itself
+func (msg MsgTerminateContract) GetSigners() []sdk.AccAddress {
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{contractAddr}
+}
+
+func (msg MsgProposeContractMigration) Route() string {
+	return RouterKey
+}
+
+func (msg MsgProposeContractMigration) Type() string {
+	return "propose-contract-migration"
+}
+
+func (msg MsgProposeContractMigration) ValidateBasic() error {
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	if msg.ExecuteAfterHeight <= 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "execute after height must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgProposeContractMigration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgProposeContractMigration) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelContractMigration) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelContractMigration) Type() string {
+	return "cancel-contract-migration"
+}
+
+func (msg MsgCancelContractMigration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelContractMigration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelContractMigration) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -77,6 +78,32 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: true,
 		},
+		"with source checksum": {
+			msg: MsgStoreCode{
+				Sender:         goodAddress,
+				WASMByteCode:   []byte("foo"),
+				Source:         "https://crates.io/api/v1/crates/cw-erc20/0.1.0/download",
+				SourceChecksum: bytes.Repeat([]byte{0x2}, 32),
+			},
+			valid: true,
+		},
+		"source checksum wrong length": {
+			msg: MsgStoreCode{
+				Sender:         goodAddress,
+				WASMByteCode:   []byte("foo"),
+				Source:         "https://crates.io/api/v1/crates/cw-erc20/0.1.0/download",
+				SourceChecksum: bytes.Repeat([]byte{0x2}, 31),
+			},
+			valid: false,
+		},
+		"source checksum without source": {
+			msg: MsgStoreCode{
+				Sender:         goodAddress,
+				WASMByteCode:   []byte("foo"),
+				SourceChecksum: bytes.Repeat([]byte{0x2}, 32),
+			},
+			valid: false,
+		},
 		"invalid builder": {
 			msg: MsgStoreCode{
 				Sender:       goodAddress,
@@ -104,16 +131,22 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: false,
 		},
-		/*
-			"invalid InstantiatePermission": {
-				msg: MsgStoreCode{
-					Sender:       goodAddress,
-					WASMByteCode: []byte("foo"),
-					InstantiatePermission: &AccessConfig{Type: OnlyAddress, Address: badAddress},
-				},
-				valid: false,
+		"invalid InstantiatePermission": {
+			msg: MsgStoreCode{
+				Sender:                goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &AccessConfig{Permission: AccessTypeOnlyAddress, Address: badAddress},
 			},
-		*/
+			valid: false,
+		},
+		"valid InstantiatePermission": {
+			msg: MsgStoreCode{
+				Sender:                goodAddress,
+				WASMByteCode:          []byte("foo"),
+				InstantiatePermission: &AccessConfig{Permission: AccessTypeAnyOfAddresses, Addresses: []sdk.AccAddress{goodAddress}},
+			},
+			valid: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -203,6 +236,74 @@ func TestInstantiateContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"with salt": {
+			msg: MsgInstantiateContract{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    []byte("salt"),
+			},
+			valid: true,
+		},
+		"init msg at max size": {
+			msg: MsgInstantiateContract{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Label:   "foo",
+				InitMsg: make([]byte, MaxContractMsgSize),
+			},
+			valid: true,
+		},
+		"init msg too long": {
+			msg: MsgInstantiateContract{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Label:   "foo",
+				InitMsg: make([]byte, MaxContractMsgSize+1),
+			},
+			valid: false,
+		},
+		"salt too long": {
+			msg: MsgInstantiateContract{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+				Salt:    make([]byte, MaxSaltSize+1),
+			},
+			valid: false,
+		},
+		"with send whitelist": {
+			msg: MsgInstantiateContract{
+				Sender:        goodAddress,
+				CodeID:        1,
+				Label:         "foo",
+				InitMsg:       []byte("{}"),
+				SendWhitelist: []string{goodAddress.String()},
+			},
+			valid: true,
+		},
+		"invalid send whitelist address": {
+			msg: MsgInstantiateContract{
+				Sender:        goodAddress,
+				CodeID:        1,
+				Label:         "foo",
+				InitMsg:       []byte("{}"),
+				SendWhitelist: []string{"not an address"},
+			},
+			valid: false,
+		},
+		"duplicate send whitelist address": {
+			msg: MsgInstantiateContract{
+				Sender:        goodAddress,
+				CodeID:        1,
+				Label:         "foo",
+				InitMsg:       []byte("{}"),
+				SendWhitelist: []string{goodAddress.String(), goodAddress.String()},
+			},
+			valid: false,
+		},
 		/*
 			"non json init msg": {
 				msg: MsgInstantiateContract{
@@ -258,6 +359,22 @@ func TestExecuteContractValidation(t *testing.T) {
 			},
 			valid: true,
 		},
+		"msg at max size": {
+			msg: MsgExecuteContract{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+				Msg:      make([]byte, MaxContractMsgSize),
+			},
+			valid: true,
+		},
+		"msg too long": {
+			msg: MsgExecuteContract{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+				Msg:      make([]byte, MaxContractMsgSize+1),
+			},
+			valid: false,
+		},
 		"correct all": {
 			msg: MsgExecuteContract{
 				Sender:    goodAddress,
@@ -297,6 +414,53 @@ func TestExecuteContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"with correlation id": {
+			msg: MsgExecuteContract{
+				Sender:        goodAddress,
+				Contract:      goodAddress,
+				Msg:           []byte(`{"some": "data"}`),
+				CorrelationID: strings.Repeat("a", MaxCorrelationIDSize),
+			},
+			valid: true,
+		},
+		"correlation id too long": {
+			msg: MsgExecuteContract{
+				Sender:        goodAddress,
+				Contract:      goodAddress,
+				Msg:           []byte(`{"some": "data"}`),
+				CorrelationID: strings.Repeat("a", MaxCorrelationIDSize+1),
+			},
+			valid: false,
+		},
+		"read only": {
+			msg: MsgExecuteContract{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+				Msg:      []byte(`{"some": "data"}`),
+				ReadOnly: true,
+			},
+			valid: true,
+		},
+		"read only with funds": {
+			msg: MsgExecuteContract{
+				Sender:    goodAddress,
+				Contract:  goodAddress,
+				Msg:       []byte(`{"some": "data"}`),
+				SentFunds: sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				ReadOnly:  true,
+			},
+			valid: false,
+		},
+		"read only with correlation id": {
+			msg: MsgExecuteContract{
+				Sender:        goodAddress,
+				Contract:      goodAddress,
+				Msg:           []byte(`{"some": "data"}`),
+				CorrelationID: "a",
+				ReadOnly:      true,
+			},
+			valid: false,
+		},
 		"negative funds": {
 			msg: MsgExecuteContract{
 				Sender:    goodAddress,
@@ -345,3 +509,162 @@ func TestExecuteContractValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateAdminValidation(t *testing.T) {
+	sender := sdk.AccAddress(make([]byte, 20)).String()
+	contract := sdk.AccAddress(append(make([]byte, 19), 1)).String()
+	newAdmin := sdk.AccAddress(append(make([]byte, 19), 2)).String()
+
+	cases := map[string]struct {
+		msg   MsgUpdateAdmin
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgUpdateAdmin{},
+			valid: false,
+		},
+		"correct": {
+			msg: MsgUpdateAdmin{
+				Sender:   sender,
+				Contract: contract,
+				NewAdmin: newAdmin,
+			},
+			valid: true,
+		},
+		"bad new admin": {
+			msg: MsgUpdateAdmin{
+				Sender:   sender,
+				Contract: contract,
+				NewAdmin: "foo",
+			},
+			valid: false,
+		},
+		"new admin is the sender": {
+			msg: MsgUpdateAdmin{
+				Sender:   sender,
+				Contract: contract,
+				NewAdmin: sender,
+			},
+			valid: false,
+		},
+		"new admin is the contract": {
+			msg: MsgUpdateAdmin{
+				Sender:   sender,
+				Contract: contract,
+				NewAdmin: contract,
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestClearAdminValidation(t *testing.T) {
+	sender := sdk.AccAddress(make([]byte, 20)).String()
+	contract := sdk.AccAddress(append(make([]byte, 19), 1)).String()
+
+	cases := map[string]struct {
+		msg   MsgClearAdmin
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgClearAdmin{},
+			valid: false,
+		},
+		"correct": {
+			msg: MsgClearAdmin{
+				Sender:   sender,
+				Contract: contract,
+			},
+			valid: true,
+		},
+		"bad contract": {
+			msg: MsgClearAdmin{
+				Sender:   sender,
+				Contract: "foo",
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestProposeContractMigrationValidation(t *testing.T) {
+	sender := sdk.AccAddress(make([]byte, 20)).String()
+	contract := sdk.AccAddress(append(make([]byte, 19), 1)).String()
+
+	cases := map[string]struct {
+		msg   MsgProposeContractMigration
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgProposeContractMigration{},
+			valid: false,
+		},
+		"correct": {
+			msg: MsgProposeContractMigration{
+				Sender:             sender,
+				Contract:           contract,
+				CodeID:             1,
+				ExecuteAfterHeight: 100,
+			},
+			valid: true,
+		},
+		"missing code id": {
+			msg: MsgProposeContractMigration{
+				Sender:             sender,
+				Contract:           contract,
+				ExecuteAfterHeight: 100,
+			},
+			valid: false,
+		},
+		"missing execute after height": {
+			msg: MsgProposeContractMigration{
+				Sender:   sender,
+				Contract: contract,
+				CodeID:   1,
+			},
+			valid: false,
+		},
+		"bad contract": {
+			msg: MsgProposeContractMigration{
+				Sender:             sender,
+				Contract:           "foo",
+				CodeID:             1,
+				ExecuteAfterHeight: 100,
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
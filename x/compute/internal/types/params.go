@@ -0,0 +1,221 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultComputeGasMultiplier charges contract execution exactly the gas it reports
+const DefaultComputeGasMultiplier uint64 = 1
+
+// MaxStorageRefundPercent caps the storage deletion refund of a contract call at this percentage
+// of the gas the call used, so deleting state can't make a call cheaper than running it
+const MaxStorageRefundPercent uint64 = 20
+
+var (
+	// ParamStoreKeyMaxContractStorageBytes is the param key for the per-contract storage limit
+	ParamStoreKeyMaxContractStorageBytes = []byte("MaxContractStorageBytes")
+	// ParamStoreKeyMaxContractMsgSize is the param key for the init/execute message size limit
+	ParamStoreKeyMaxContractMsgSize = []byte("MaxContractMsgSize")
+	// ParamStoreKeyComputeGasMultiplier is the param key for the contract execution gas multiplier
+	ParamStoreKeyComputeGasMultiplier = []byte("ComputeGasMultiplier")
+	// ParamStoreKeyIbcReceiverWhitelist is the param key for the contracts allowed to receive IBC packets
+	ParamStoreKeyIbcReceiverWhitelist = []byte("IbcReceiverWhitelist")
+	// ParamStoreKeyMaxBlockComputeGas is the param key for the per-block contract gas limit
+	ParamStoreKeyMaxBlockComputeGas = []byte("MaxBlockComputeGas")
+	// ParamStoreKeyMaxInstantiatesPerBlockPerAccount is the param key for the per-account instantiation limit
+	ParamStoreKeyMaxInstantiatesPerBlockPerAccount = []byte("MaxInstantiatesPerBlockPerAccount")
+	// ParamStoreKeyInstantiateLimitExempt is the param key for the accounts the instantiation limit doesn't apply to
+	ParamStoreKeyInstantiateLimitExempt = []byte("InstantiateLimitExempt")
+	// ParamStoreKeyStorageDeletionRefundPercent is the param key for the gas refund of deleted contract state
+	ParamStoreKeyStorageDeletionRefundPercent = []byte("StorageDeletionRefundPercent")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable returns the parameter key table for the compute module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default compute params. Contract storage is unlimited by default,
+// so existing contracts keep working until governance sets a limit, messages may be as
+// large as ValidateBasic allows, contract gas is charged as is, every contract may receive
+// IBC packets, blocks may use unlimited contract gas, accounts may instantiate any number of
+// contracts per block and deleting contract state isn't refunded.
+func DefaultParams() Params {
+	return Params{
+		MaxContractStorageBytes:           0,
+		MaxContractMsgSize:                MaxContractMsgSize,
+		ComputeGasMultiplier:              DefaultComputeGasMultiplier,
+		IbcReceiverWhitelist:              []string{},
+		MaxBlockComputeGas:                0,
+		MaxInstantiatesPerBlockPerAccount: 0,
+		InstantiateLimitExempt:            []string{},
+		StorageDeletionRefundPercent:      0,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxContractStorageBytes, &p.MaxContractStorageBytes, validateMaxContractStorageBytes),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxContractMsgSize, &p.MaxContractMsgSize, validateMaxContractMsgSize),
+		paramtypes.NewParamSetPair(ParamStoreKeyComputeGasMultiplier, &p.ComputeGasMultiplier, validateComputeGasMultiplier),
+		paramtypes.NewParamSetPair(ParamStoreKeyIbcReceiverWhitelist, &p.IbcReceiverWhitelist, validateIbcReceiverWhitelist),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxBlockComputeGas, &p.MaxBlockComputeGas, validateMaxBlockComputeGas),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxInstantiatesPerBlockPerAccount, &p.MaxInstantiatesPerBlockPerAccount, validateMaxInstantiatesPerBlockPerAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeyInstantiateLimitExempt, &p.InstantiateLimitExempt, validateInstantiateLimitExempt),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDeletionRefundPercent, &p.StorageDeletionRefundPercent, validateStorageDeletionRefundPercent),
+	}
+}
+
+// ValidateBasic performs basic validation on compute parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateMaxContractStorageBytes(p.MaxContractStorageBytes); err != nil {
+		return sdkerrors.Wrap(err, "max contract storage bytes")
+	}
+	if err := validateMaxContractMsgSize(p.MaxContractMsgSize); err != nil {
+		return sdkerrors.Wrap(err, "max contract msg size")
+	}
+	if err := validateComputeGasMultiplier(p.ComputeGasMultiplier); err != nil {
+		return sdkerrors.Wrap(err, "compute gas multiplier")
+	}
+	if err := validateIbcReceiverWhitelist(p.IbcReceiverWhitelist); err != nil {
+		return sdkerrors.Wrap(err, "ibc receiver whitelist")
+	}
+	if err := validateMaxBlockComputeGas(p.MaxBlockComputeGas); err != nil {
+		return sdkerrors.Wrap(err, "max block compute gas")
+	}
+	if err := validateMaxInstantiatesPerBlockPerAccount(p.MaxInstantiatesPerBlockPerAccount); err != nil {
+		return sdkerrors.Wrap(err, "max instantiates per block per account")
+	}
+	if err := validateInstantiateLimitExempt(p.InstantiateLimitExempt); err != nil {
+		return sdkerrors.Wrap(err, "instantiate limit exempt")
+	}
+	if err := validateStorageDeletionRefundPercent(p.StorageDeletionRefundPercent); err != nil {
+		return sdkerrors.Wrap(err, "storage deletion refund percent")
+	}
+	return nil
+}
+
+// IsIbcReceiverWhitelisted returns whether incoming IBC packets may be routed to contractAddress
+func (p Params) IsIbcReceiverWhitelisted(contractAddress sdk.AccAddress) bool {
+	if len(p.IbcReceiverWhitelist) == 0 {
+		return true
+	}
+	for _, addr := range p.IbcReceiverWhitelist {
+		if addr == contractAddress.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInstantiateLimitExempt returns whether MaxInstantiatesPerBlockPerAccount doesn't apply to addr
+func (p Params) IsInstantiateLimitExempt(addr sdk.AccAddress) bool {
+	for _, exempt := range p.InstantiateLimitExempt {
+		if exempt == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func validateMaxContractStorageBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// validateMaxContractMsgSize only allows lowering the limit: messages are checked against
+// MaxContractMsgSize in ValidateBasic already, which can't read the params.
+func validateMaxContractMsgSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("must be positive")
+	}
+	if v > MaxContractMsgSize {
+		return fmt.Errorf("cannot be larger than %d", MaxContractMsgSize)
+	}
+	return nil
+}
+
+func validateComputeGasMultiplier(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 1 {
+		return fmt.Errorf("must be at least 1")
+	}
+	return nil
+}
+
+func validateIbcReceiverWhitelist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("contract address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate contract address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+func validateMaxBlockComputeGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxInstantiatesPerBlockPerAccount(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateInstantiateLimitExempt(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+func validateStorageDeletionRefundPercent(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > 100 {
+		return fmt.Errorf("cannot be more than 100")
+	}
+	return nil
+}
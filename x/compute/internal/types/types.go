@@ -20,6 +20,9 @@ const (
 	defaultLRUCacheSize        = uint64(0)
 	defaultEnclaveLRUCacheSize = uint16(100)
 	defaultQueryGasLimit       = uint64(10_000_000)
+	// defaultQueryGasLimitPerMessage is zero, which disables the per-message limit
+	defaultQueryGasLimitPerMessage = uint64(0)
+	defaultMetricsTrackedCodes     = uint32(20)
 )
 
 func (m Model) ValidateBasic() error {
@@ -42,18 +45,27 @@ func (c CodeInfo) ValidateBasic() error {
 	if err := validateBuilder(c.Builder); err != nil {
 		return sdkerrors.Wrap(err, "builder")
 	}
+	if err := validateSourceChecksum(c.SourceChecksum, c.Source); err != nil {
+		return sdkerrors.Wrap(err, "source checksum")
+	}
+	// An undefined config is only found on legacy codes, which are imported as open to everybody
+	if c.InstantiateConfig.Permission != AccessTypeUndefined {
+		if err := c.InstantiateConfig.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate config")
+		}
+	}
 
 	return nil
 }
 
 // NewCodeInfo fills a new Contract struct
-func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, source string, builder string) CodeInfo {
+func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, source string, builder string, instantiatePermission AccessConfig) CodeInfo {
 	return CodeInfo{
-		CodeHash: codeHash,
-		Creator:  creator,
-		Source:   source,
-		Builder:  builder,
-		// InstantiateConfig: instantiatePermission,
+		CodeHash:          codeHash,
+		Creator:           creator,
+		Source:            source,
+		Builder:           builder,
+		InstantiateConfig: instantiatePermission,
 	}
 }
 
@@ -79,9 +91,25 @@ func (c *ContractInfo) ValidateBasic() error {
 	if err := validateLabel(c.Label); err != nil {
 		return sdkerrors.Wrap(err, "label")
 	}
+	if err := validateSendWhitelist(c.SendWhitelist); err != nil {
+		return sdkerrors.Wrap(err, "send whitelist")
+	}
 	return nil
 }
 
+// CanSendTo returns whether the SendWhitelist of the contract allows bank sends to recipient
+func (c *ContractInfo) CanSendTo(recipient string) bool {
+	if len(c.SendWhitelist) == 0 {
+		return true
+	}
+	for _, addr := range c.SendWhitelist {
+		if addr == recipient {
+			return true
+		}
+	}
+	return false
+}
+
 // LessThan can be used to sort
 func (a *AbsoluteTxPosition) LessThan(b *AbsoluteTxPosition) bool {
 	if a == nil {
@@ -242,16 +270,25 @@ func contractSDKEventAttributes(customAttributes []wasmTypesV010.LogAttribute, c
 // WasmConfig is the extra config required for wasm
 type WasmConfig struct {
 	SmartQueryGasLimit uint64
-	CacheSize          uint64
-	EnclaveCacheSize   uint16
+	// QueryGasLimitPerMessage caps the gas a single contract query served to a client (including each
+	// nested contract-to-contract query) may use. Zero means the query is only bounded by the caller's
+	// gas. Queries contracts make while executing a tx aren't affected.
+	QueryGasLimitPerMessage uint64
+	CacheSize               uint64
+	EnclaveCacheSize        uint16
+	// MetricsTrackedCodes is the number of code IDs, by gas used, that get their own label in the
+	// execution metrics. All other codes are reported together.
+	MetricsTrackedCodes uint32
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
 func DefaultWasmConfig() *WasmConfig {
 	return &WasmConfig{
-		SmartQueryGasLimit: defaultQueryGasLimit,
-		CacheSize:          defaultLRUCacheSize,
-		EnclaveCacheSize:   defaultEnclaveLRUCacheSize,
+		SmartQueryGasLimit:      defaultQueryGasLimit,
+		QueryGasLimitPerMessage: defaultQueryGasLimitPerMessage,
+		CacheSize:               defaultLRUCacheSize,
+		EnclaveCacheSize:        defaultEnclaveLRUCacheSize,
+		MetricsTrackedCodes:     defaultMetricsTrackedCodes,
 	}
 }
 
@@ -300,11 +337,20 @@ func GetConfig(appOpts servertypes.AppOptions) *WasmConfig {
 		config.SmartQueryGasLimit = updatedGasLimit
 	}
 
+	queryGasLimitPerMessage := cast.ToUint64(appOpts.Get("wasm.contract-query-gas-limit-per-message"))
+	if queryGasLimitPerMessage > 0 {
+		config.QueryGasLimitPerMessage = queryGasLimitPerMessage
+	}
+
 	enclaveCacheSize := cast.ToUint16(appOpts.Get("wasm.contract-memory-enclave-cache-size"))
 	if enclaveCacheSize > 0 {
 		config.EnclaveCacheSize = enclaveCacheSize
 	}
 
+	if metricsTrackedCodes := appOpts.Get("wasm.contract-metrics-tracked-codes"); metricsTrackedCodes != nil {
+		config.MetricsTrackedCodes = cast.ToUint32(metricsTrackedCodes)
+	}
+
 	return config
 }
 
@@ -316,11 +362,22 @@ const DefaultConfigTemplate = `
 # so we need to restrict the max usage to prevent DoS attack
 contract-query-gas-limit = "{{ .WASMConfig.SmartQueryGasLimit }}"
 
+# The maximum gas amount a single contract query may spend, including every nested
+# contract-to-contract query it triggers. This stops one runaway query from draining
+# the whole query budget. 0 disables the per-message limit. Queries contracts make while
+# executing a tx aren't affected, since their gas must be the same on every node.
+contract-query-gas-limit-per-message = "{{ .WASMConfig.QueryGasLimitPerMessage }}"
+
 # The WASM VM memory cache size in MiB not bytes
 contract-memory-cache-size = "{{ .WASMConfig.CacheSize }}"
 
 # The WASM VM memory cache size in number of cached modules. Can safely go up to 15, but not recommended for validators
 contract-memory-enclave-cache-size = "{{ .WASMConfig.EnclaveCacheSize }}"
+
+# The number of code IDs that get their own code_id label in the contract execution metrics,
+# picked by the gas they used since the node started. All other codes are reported as "other".
+# 0 reports every execution as "other".
+contract-metrics-tracked-codes = "{{ .WASMConfig.MetricsTrackedCodes }}"
 `
 
 // ZeroSender is a valid 20 byte canonical address that's used to bypass the x/compute checks
@@ -349,6 +406,32 @@ func (c ContractInfo) InitialHistory(initMsg []byte) ContractCodeHistoryEntry {
 	}
 }
 
+// ResetFromGenesis sets the created position of a contract imported from genesis without one to the
+// current block and returns the history entry to start its code history with
+func (c *ContractInfo) ResetFromGenesis(ctx sdk.Context) ContractCodeHistoryEntry {
+	if c.Created == nil {
+		c.Created = NewAbsoluteTxPosition(ctx)
+	}
+	return ContractCodeHistoryEntry{
+		Operation: ContractCodeHistoryOperationTypeGenesis,
+		CodeID:    c.CodeID,
+		Updated:   c.Created,
+	}
+}
+
+func (e ContractCodeHistoryEntry) ValidateBasic() error {
+	if e.Operation == ContractCodeHistoryOperationTypeUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "operation")
+	}
+	if e.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if e.Updated == nil {
+		return sdkerrors.Wrap(ErrEmpty, "updated")
+	}
+	return nil
+}
+
 func (c *ContractInfo) AddMigration(ctx sdk.Context, codeID uint64, msg []byte) ContractCodeHistoryEntry {
 	h := ContractCodeHistoryEntry{
 		Operation: ContractCodeHistoryOperationTypeMigrate,
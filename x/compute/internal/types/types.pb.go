@@ -29,10 +29,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type AccessType int32
 
 const (
-	AccessTypeUndefined   AccessType = 0
-	AccessTypeNobody      AccessType = 1
-	AccessTypeOnlyAddress AccessType = 2
-	AccessTypeEverybody   AccessType = 3
+	AccessTypeUndefined      AccessType = 0
+	AccessTypeNobody         AccessType = 1
+	AccessTypeOnlyAddress    AccessType = 2
+	AccessTypeEverybody      AccessType = 3
+	AccessTypeAnyOfAddresses AccessType = 4
 )
 
 var AccessType_name = map[int32]string{
@@ -40,13 +41,15 @@ var AccessType_name = map[int32]string{
 	1: "NOBODY",
 	2: "ONLY_ADDRESS",
 	3: "EVERYBODY",
+	4: "ANY_OF_ADDRESSES",
 }
 
 var AccessType_value = map[string]int32{
-	"UNDEFINED":    0,
-	"NOBODY":       1,
-	"ONLY_ADDRESS": 2,
-	"EVERYBODY":    3,
+	"UNDEFINED":        0,
+	"NOBODY":           1,
+	"ONLY_ADDRESS":     2,
+	"EVERYBODY":        3,
+	"ANY_OF_ADDRESSES": 4,
 }
 
 func (AccessType) EnumDescriptor() ([]byte, []int) {
@@ -126,19 +129,169 @@ func (m *AccessTypeParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessTypeParam proto.InternalMessageInfo
 
+// Params defines the set of compute parameters
+type Params struct {
+	// MaxContractStorageBytes is the maximum number of bytes (keys and values) a single contract
+	// may hold in its state. Zero means unlimited.
+	MaxContractStorageBytes uint64 `protobuf:"varint,1,opt,name=max_contract_storage_bytes,json=maxContractStorageBytes,proto3" json:"max_contract_storage_bytes,omitempty" yaml:"max_contract_storage_bytes"`
+	// MaxContractMsgSize is the maximum size in bytes of the encrypted message passed to
+	// a contract's init or execute entry point.
+	MaxContractMsgSize uint64 `protobuf:"varint,2,opt,name=max_contract_msg_size,json=maxContractMsgSize,proto3" json:"max_contract_msg_size,omitempty" yaml:"max_contract_msg_size"`
+	// ComputeGasMultiplier multiplies the gas charged for running a contract in the enclave.
+	// It is at least 1, so contracts can't be made cheaper than plain wasm gas.
+	ComputeGasMultiplier uint64 `protobuf:"varint,3,opt,name=compute_gas_multiplier,json=computeGasMultiplier,proto3" json:"compute_gas_multiplier,omitempty" yaml:"compute_gas_multiplier"`
+	// IbcReceiverWhitelist are the bech32 addresses of the contracts incoming IBC packets may be
+	// routed to. Empty means every contract may receive packets.
+	IbcReceiverWhitelist []string `protobuf:"bytes,4,rep,name=ibc_receiver_whitelist,json=ibcReceiverWhitelist,proto3" json:"ibc_receiver_whitelist,omitempty" yaml:"ibc_receiver_whitelist"`
+	// MaxBlockComputeGas is the total gas the instantiate and execute messages of a block may use.
+	// Once it is used up, further such messages in the block are rejected. Zero means unlimited.
+	MaxBlockComputeGas uint64 `protobuf:"varint,5,opt,name=max_block_compute_gas,json=maxBlockComputeGas,proto3" json:"max_block_compute_gas,omitempty" yaml:"max_block_compute_gas"`
+	// MaxInstantiatesPerBlockPerAccount is the number of contracts a single account may
+	// instantiate in a block, including contracts instantiated by contracts. Zero means unlimited.
+	MaxInstantiatesPerBlockPerAccount uint64 `protobuf:"varint,6,opt,name=max_instantiates_per_block_per_account,json=maxInstantiatesPerBlockPerAccount,proto3" json:"max_instantiates_per_block_per_account,omitempty" yaml:"max_instantiates_per_block_per_account"`
+	// InstantiateLimitExempt are the bech32 addresses of the accounts (e.g. contracts that
+	// instantiate children) MaxInstantiatesPerBlockPerAccount doesn't apply to.
+	InstantiateLimitExempt []string `protobuf:"bytes,7,rep,name=instantiate_limit_exempt,json=instantiateLimitExempt,proto3" json:"instantiate_limit_exempt,omitempty" yaml:"instantiate_limit_exempt"`
+	// StorageDeletionRefundPercent is the percentage of the per-byte write gas of the state entries a
+	// contract deletes that is credited back to the tx, at most MaxStorageRefundPercent of the gas
+	// the call used. Zero disables refunds.
+	StorageDeletionRefundPercent uint64 `protobuf:"varint,8,opt,name=storage_deletion_refund_percent,json=storageDeletionRefundPercent,proto3" json:"storage_deletion_refund_percent,omitempty" yaml:"storage_deletion_refund_percent"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// AccessConfig restricts which accounts may instantiate contracts from a stored code
+type AccessConfig struct {
+	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=secret.compute.v1beta1.AccessType" json:"permission,omitempty" yaml:"permission"`
+	// Address is the only account allowed to instantiate when permission is ONLY_ADDRESS
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
+	// Addresses are the accounts allowed to instantiate when permission is ANY_OF_ADDRESSES
+	Addresses []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,rep,name=addresses,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"addresses,omitempty" yaml:"addresses"`
+}
+
+func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
+func (m *AccessConfig) String() string { return proto.CompactTextString(m) }
+func (*AccessConfig) ProtoMessage()    {}
+func (*AccessConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{2}
+}
+func (m *AccessConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessConfig.Merge(m, src)
+}
+func (m *AccessConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
+
+// ContractSalt records the salt a contract with a predictable address was instantiated with
+type ContractSalt struct {
+	CodeID          uint64                                        `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	Creator         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
+	Salt            []byte                                        `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	ContractAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"contract_address,omitempty"`
+}
+
+func (m *ContractSalt) Reset()         { *m = ContractSalt{} }
+func (m *ContractSalt) String() string { return proto.CompactTextString(m) }
+func (*ContractSalt) ProtoMessage()    {}
+func (*ContractSalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{3}
+}
+func (m *ContractSalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSalt.Merge(m, src)
+}
+func (m *ContractSalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSalt proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	CodeHash []byte                                        `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 	Creator  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=creator,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"creator,omitempty"`
 	Source   string                                        `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
 	Builder  string                                        `protobuf:"bytes,4,opt,name=builder,proto3" json:"builder,omitempty"`
+	// InstantiateConfig restricts who may instantiate contracts from this code
+	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+	// SourceChecksum is the sha256 checksum of the source archive at source, as claimed by the creator.
+	// It is recorded for external verifiers and never checked on chain.
+	SourceChecksum []byte `protobuf:"bytes,6,opt,name=source_checksum,json=sourceChecksum,proto3" json:"source_checksum,omitempty"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{1}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{4}
 }
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,7 +330,7 @@ func (m *ContractKey) Reset()         { *m = ContractKey{} }
 func (m *ContractKey) String() string { return proto.CompactTextString(m) }
 func (*ContractKey) ProtoMessage()    {}
 func (*ContractKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{2}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{5}
 }
 func (m *ContractKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +368,7 @@ func (m *ContractCustomInfo) Reset()         { *m = ContractCustomInfo{} }
 func (m *ContractCustomInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractCustomInfo) ProtoMessage()    {}
 func (*ContractCustomInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{3}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{6}
 }
 func (m *ContractCustomInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -259,13 +412,29 @@ type ContractInfo struct {
 	Admin string `protobuf:"bytes,7,opt,name=admin,proto3" json:"admin,omitempty"`
 	// Proof that enclave executed the instantiate command
 	AdminProof []byte `protobuf:"bytes,8,opt,name=admin_proof,json=adminProof,proto3" json:"admin_proof,omitempty"`
+	// Paused is set by the admin to reject all executions of the contract, queries are still allowed
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	// ReentrancyProtected is set at instantiation to reject executions of the contract that start
+	// while another execution of it hasn't finished yet
+	ReentrancyProtected bool `protobuf:"varint,10,opt,name=reentrancy_protected,json=reentrancyProtected,proto3" json:"reentrancy_protected,omitempty"`
+	// Terminated is set when the contract ended itself with MsgTerminateContract. A terminated
+	// contract can't be executed, migrated or queried anymore. Bank sends to it still succeed,
+	// and the funds they send are locked for good
+	Terminated bool `protobuf:"varint,11,opt,name=terminated,proto3" json:"terminated,omitempty"`
+	// SendWhitelist is set at instantiation to restrict the addresses the contract can send funds
+	// to with bank messages. Empty allows all addresses
+	SendWhitelist []string `protobuf:"bytes,12,rep,name=send_whitelist,json=sendWhitelist,proto3" json:"send_whitelist,omitempty"`
+	// MigrationTimelocked is set when the admin proposes the first migration of the contract. From
+	// then on the contract can only be migrated to the code of a pending migration whose challenge
+	// window is over
+	MigrationTimelocked bool `protobuf:"varint,13,opt,name=migration_timelocked,json=migrationTimelocked,proto3" json:"migration_timelocked,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{4}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{7}
 }
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +475,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{5}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{8}
 }
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +516,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{6}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{9}
 }
 func (m *Model) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +559,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{7}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{10}
 }
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,10 +588,146 @@ func (m *ContractCodeHistoryEntry) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractCodeHistoryEntry proto.InternalMessageInfo
 
+// ContractExecutionResult is the result of an execution that was tagged with a correlation id
+type ContractExecutionResult struct {
+	// contract_address is the bech32 human readable address of the executed contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// data is the result data of the execution, encrypted for the sender like in MsgExecuteContractResponse
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// height is the block height the execution was included in
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ContractExecutionResult) Reset()         { *m = ContractExecutionResult{} }
+func (m *ContractExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ContractExecutionResult) ProtoMessage()    {}
+func (*ContractExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{11}
+}
+func (m *ContractExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractExecutionResult.Merge(m, src)
+}
+func (m *ContractExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractExecutionResult proto.InternalMessageInfo
+
+// IndexedEventAttribute is a plaintext event attribute a contract marked to be indexed
+type IndexedEventAttribute struct {
+	// event_type is the type of the event the attribute was emitted in
+	EventType string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// key is the attribute key without the "indexed:" prefix
+	Key   string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// height is the block height the event was emitted at
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *IndexedEventAttribute) Reset()         { *m = IndexedEventAttribute{} }
+func (m *IndexedEventAttribute) String() string { return proto.CompactTextString(m) }
+func (*IndexedEventAttribute) ProtoMessage()    {}
+func (*IndexedEventAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{12}
+}
+func (m *IndexedEventAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedEventAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedEventAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedEventAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedEventAttribute.Merge(m, src)
+}
+func (m *IndexedEventAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedEventAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedEventAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedEventAttribute proto.InternalMessageInfo
+
+// PendingMigration is a migration the admin of a contract announced with MsgProposeContractMigration
+type PendingMigration struct {
+	// contract_address is the bech32 human readable address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// CodeID is the code the contract will be migrated to
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// proposed_at_height is the block height the migration was proposed at
+	ProposedAtHeight int64 `protobuf:"varint,3,opt,name=proposed_at_height,json=proposedAtHeight,proto3" json:"proposed_at_height,omitempty"`
+	// execute_after_height is the last block of the challenge window, the migration can be executed
+	// with MsgMigrateContract in any later block
+	ExecuteAfterHeight int64 `protobuf:"varint,4,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty"`
+}
+
+func (m *PendingMigration) Reset()         { *m = PendingMigration{} }
+func (m *PendingMigration) String() string { return proto.CompactTextString(m) }
+func (*PendingMigration) ProtoMessage()    {}
+func (*PendingMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{13}
+}
+func (m *PendingMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMigration.Merge(m, src)
+}
+func (m *PendingMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMigration proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("secret.compute.v1beta1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("secret.compute.v1beta1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "secret.compute.v1beta1.AccessTypeParam")
+	proto.RegisterType((*Params)(nil), "secret.compute.v1beta1.Params")
+	proto.RegisterType((*AccessConfig)(nil), "secret.compute.v1beta1.AccessConfig")
+	proto.RegisterType((*ContractSalt)(nil), "secret.compute.v1beta1.ContractSalt")
 	proto.RegisterType((*CodeInfo)(nil), "secret.compute.v1beta1.CodeInfo")
 	proto.RegisterType((*ContractKey)(nil), "secret.compute.v1beta1.ContractKey")
 	proto.RegisterType((*ContractCustomInfo)(nil), "secret.compute.v1beta1.ContractCustomInfo")
@@ -430,6 +735,9 @@ func init() {
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "secret.compute.v1beta1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "secret.compute.v1beta1.Model")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "secret.compute.v1beta1.ContractCodeHistoryEntry")
+	proto.RegisterType((*ContractExecutionResult)(nil), "secret.compute.v1beta1.ContractExecutionResult")
+	proto.RegisterType((*IndexedEventAttribute)(nil), "secret.compute.v1beta1.IndexedEventAttribute")
+	proto.RegisterType((*PendingMigration)(nil), "secret.compute.v1beta1.PendingMigration")
 }
 
 func init() {
@@ -437,73 +745,121 @@ func init() {
 }
 
 var fileDescriptor_8ba7f40a6d1951b3 = []byte{
-	// 1053 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1a, 0x47,
-	0x1f, 0x67, 0x0d, 0x06, 0x33, 0x90, 0x04, 0xcd, 0xe3, 0x27, 0x21, 0x54, 0x02, 0xba, 0xa9, 0x52,
-	0xd7, 0xae, 0x21, 0x4e, 0x7b, 0x88, 0xdc, 0x13, 0x2f, 0x1b, 0x7b, 0xe3, 0x7a, 0x41, 0x03, 0x76,
-	0xe5, 0xaa, 0xd5, 0x6a, 0x5f, 0xc6, 0xb0, 0xf2, 0xb2, 0x83, 0x66, 0x06, 0x97, 0xbd, 0xf5, 0x58,
-	0x71, 0xea, 0xb1, 0x17, 0xa4, 0x4a, 0x8d, 0xa2, 0x7c, 0x81, 0x7e, 0x81, 0x9e, 0x72, 0xcc, 0xb1,
-	0x27, 0xd4, 0xe2, 0x0f, 0x50, 0xa9, 0xc7, 0x9c, 0xaa, 0x1d, 0x16, 0x43, 0x9b, 0x58, 0x76, 0xa5,
-	0x9e, 0xfc, 0x7f, 0xfd, 0xfd, 0xdf, 0x7e, 0x3b, 0x06, 0xc8, 0x0c, 0x5b, 0x14, 0xf3, 0xb2, 0x45,
-	0x7a, 0xfd, 0x01, 0xc7, 0xe5, 0xf3, 0x1d, 0x13, 0x73, 0x63, 0xa7, 0xcc, 0xfd, 0x3e, 0x66, 0xa5,
-	0x3e, 0x25, 0x9c, 0xc0, 0xbb, 0xb3, 0x98, 0x52, 0x18, 0x53, 0x0a, 0x63, 0x72, 0xeb, 0x1d, 0xd2,
-	0x21, 0x22, 0xa4, 0x1c, 0x48, 0xb3, 0x68, 0xd9, 0x02, 0x77, 0x2a, 0x96, 0x85, 0x19, 0x6b, 0xfb,
-	0x7d, 0xdc, 0x34, 0xa8, 0xd1, 0x83, 0xcf, 0xc0, 0xea, 0xb9, 0xe1, 0x0e, 0x70, 0x56, 0x2a, 0x4a,
-	0x1b, 0xb7, 0x1f, 0xcb, 0xa5, 0x77, 0x03, 0x96, 0x16, 0x79, 0xd5, 0xcc, 0x9f, 0x93, 0x42, 0xda,
-	0x37, 0x7a, 0xee, 0xae, 0x2c, 0x52, 0x65, 0x34, 0x83, 0xd8, 0x8d, 0xfd, 0xf0, 0x63, 0x41, 0x92,
-	0x5f, 0x48, 0x60, 0xad, 0x46, 0x6c, 0xac, 0x7a, 0xa7, 0x04, 0xbe, 0x07, 0x92, 0x16, 0xb1, 0xb1,
-	0xde, 0x35, 0x58, 0x57, 0x94, 0x48, 0xa3, 0xb5, 0xc0, 0xb0, 0x6f, 0xb0, 0x2e, 0x3c, 0x00, 0x09,
-	0x8b, 0x62, 0x83, 0x13, 0x9a, 0x5d, 0x09, 0x5c, 0xd5, 0x9d, 0x37, 0x93, 0xc2, 0x76, 0xc7, 0xe1,
-	0xdd, 0x81, 0x19, 0x34, 0x50, 0xb6, 0x08, 0xeb, 0x11, 0x16, 0xfe, 0xd9, 0x66, 0xf6, 0x59, 0x38,
-	0x7b, 0xc5, 0xb2, 0x2a, 0xb6, 0x4d, 0x31, 0x63, 0x68, 0x8e, 0x00, 0xef, 0x82, 0x38, 0x23, 0x03,
-	0x6a, 0xe1, 0x6c, 0xb4, 0x28, 0x6d, 0x24, 0x51, 0xa8, 0xc1, 0x2c, 0x48, 0x98, 0x03, 0xc7, 0xb5,
-	0x31, 0xcd, 0xc6, 0x84, 0x63, 0xae, 0xca, 0xcf, 0x25, 0x90, 0xaa, 0x11, 0x8f, 0x53, 0xc3, 0xe2,
-	0x07, 0xd8, 0x87, 0x0f, 0xc1, 0x1d, 0xd2, 0xd1, 0xad, 0xd0, 0xa2, 0x9f, 0x61, 0x3f, 0xec, 0xf8,
-	0x16, 0xe9, 0x2c, 0xc7, 0x3d, 0x02, 0xeb, 0xd6, 0x80, 0x52, 0xec, 0xf1, 0xbf, 0x07, 0x8b, 0x19,
-	0x10, 0x0c, 0x7d, 0xcb, 0x19, 0x9f, 0x81, 0xdc, 0xbb, 0x32, 0xf4, 0x3e, 0x25, 0xe4, 0x54, 0xf4,
-	0x9b, 0x46, 0xf7, 0xde, 0xce, 0x6b, 0x06, 0x6e, 0xf9, 0x5b, 0x09, 0xc0, 0xb9, 0xb1, 0x36, 0x60,
-	0x9c, 0xf4, 0xc4, 0x66, 0xdb, 0x20, 0x85, 0x3d, 0xcb, 0x35, 0xce, 0xf1, 0x65, 0xa7, 0xa9, 0xc7,
-	0x0f, 0xae, 0x3a, 0xdf, 0x12, 0x6a, 0xf5, 0xf6, 0x74, 0x52, 0x00, 0xca, 0x2c, 0xf7, 0x00, 0xfb,
-	0x08, 0xe0, 0x4b, 0x19, 0xae, 0x83, 0x55, 0xd7, 0x30, 0xb1, 0x2b, 0x86, 0x49, 0xa2, 0x99, 0x22,
-	0xff, 0xb2, 0x02, 0xd2, 0x73, 0x04, 0x51, 0xfc, 0x01, 0x48, 0x88, 0xb3, 0x3a, 0xb6, 0x28, 0x1c,
-	0xab, 0x82, 0xe9, 0xa4, 0x10, 0x17, 0x57, 0xaf, 0xa3, 0x78, 0xe0, 0x52, 0xed, 0xff, 0xf6, 0xbc,
-	0x97, 0x8d, 0xc5, 0x96, 0x1a, 0x83, 0xf5, 0xb0, 0x04, 0xb6, 0xb3, 0xab, 0x62, 0x01, 0x9b, 0x57,
-	0xf2, 0xd7, 0x64, 0xc4, 0x1d, 0x70, 0xdc, 0x1e, 0x36, 0x09, 0x73, 0xb8, 0x43, 0x3c, 0x34, 0x4f,
-	0x85, 0xdb, 0x20, 0xe5, 0x98, 0x96, 0xde, 0x27, 0x94, 0x07, 0x13, 0xc5, 0x83, 0x0a, 0xd5, 0x5b,
-	0xd3, 0x49, 0x21, 0xa9, 0x56, 0x6b, 0x4d, 0x42, 0xb9, 0x5a, 0x47, 0x49, 0xc7, 0xb4, 0x84, 0x68,
-	0x07, 0xad, 0x18, 0x76, 0xcf, 0xf1, 0xb2, 0x89, 0x59, 0x2b, 0x42, 0x81, 0x05, 0x90, 0x12, 0x42,
-	0x78, 0xd4, 0x35, 0x71, 0x54, 0x20, 0x4c, 0xb3, 0x3b, 0x22, 0x00, 0xdf, 0x6e, 0x02, 0xbe, 0x0f,
-	0xd2, 0xa6, 0x4b, 0xac, 0x33, 0xbd, 0x8b, 0x9d, 0x4e, 0x97, 0x8b, 0x75, 0x46, 0x51, 0x4a, 0xd8,
-	0xf6, 0x85, 0x09, 0xde, 0x07, 0x6b, 0x7c, 0xa8, 0x3b, 0x9e, 0x8d, 0x87, 0x62, 0x91, 0x31, 0x94,
-	0xe0, 0x43, 0x35, 0x50, 0x65, 0x07, 0xac, 0x1e, 0x12, 0x1b, 0xbb, 0xf0, 0x19, 0x88, 0x1e, 0xcc,
-	0xf9, 0x5a, 0x7d, 0xf2, 0x66, 0x52, 0xf8, 0x74, 0x69, 0xcf, 0x1c, 0x7b, 0x36, 0xa6, 0x3d, 0xc7,
-	0xe3, 0xcb, 0xa2, 0xeb, 0x98, 0xac, 0x6c, 0xfa, 0x1c, 0xb3, 0xd2, 0x3e, 0x1e, 0x56, 0x03, 0x01,
-	0x45, 0x43, 0x0e, 0x1c, 0x8b, 0x27, 0x61, 0x46, 0xe8, 0x99, 0x22, 0xff, 0x21, 0x81, 0xec, 0x25,
-	0x0d, 0x83, 0x2f, 0xd8, 0x61, 0x9c, 0x50, 0x5f, 0xf1, 0x38, 0xf5, 0xe1, 0x31, 0x48, 0x92, 0x3e,
-	0xa6, 0x46, 0x30, 0x52, 0xf8, 0x92, 0x3c, 0xb9, 0x8e, 0x8a, 0x4b, 0x20, 0x8d, 0x79, 0x6e, 0xf0,
-	0xbe, 0xa0, 0x05, 0xd4, 0x32, 0xcf, 0x56, 0xae, 0xe4, 0x59, 0x1d, 0x24, 0x06, 0x7d, 0x5b, 0x90,
-	0x20, 0xfa, 0xef, 0x49, 0x10, 0xa6, 0xc2, 0x0c, 0x88, 0xf6, 0x58, 0x47, 0xd0, 0x2b, 0x8d, 0x02,
-	0x71, 0xf3, 0x67, 0x09, 0x80, 0xc5, 0xb3, 0x07, 0x1f, 0x82, 0xe4, 0x91, 0x56, 0x57, 0x9e, 0xaa,
-	0x9a, 0x52, 0xcf, 0x44, 0x72, 0xf7, 0x46, 0xe3, 0xe2, 0xff, 0x16, 0xee, 0x23, 0xcf, 0xc6, 0xa7,
-	0x8e, 0x87, 0x6d, 0x58, 0x04, 0x71, 0xad, 0x51, 0x6d, 0xd4, 0x4f, 0x32, 0x52, 0x6e, 0x7d, 0x34,
-	0x2e, 0x66, 0x16, 0x41, 0x1a, 0x31, 0x89, 0xed, 0xc3, 0x2d, 0x90, 0x6e, 0x68, 0x9f, 0x9f, 0xe8,
-	0x95, 0x7a, 0x1d, 0x29, 0xad, 0x56, 0x66, 0x25, 0x77, 0x7f, 0x34, 0x2e, 0xfe, 0x7f, 0x11, 0xd7,
-	0xf0, 0x5c, 0x3f, 0xfc, 0x02, 0x82, 0xb2, 0xca, 0xb1, 0x82, 0x4e, 0x04, 0x62, 0xf4, 0x9f, 0x65,
-	0x95, 0x73, 0x4c, 0xfd, 0x00, 0x34, 0xb7, 0xf6, 0xdd, 0x4f, 0xf9, 0xc8, 0xcb, 0xe7, 0xf9, 0xc8,
-	0xe6, 0x8b, 0x28, 0x28, 0x5e, 0xb7, 0x64, 0x88, 0xc1, 0xa3, 0x5a, 0x43, 0x6b, 0xa3, 0x4a, 0xad,
-	0xad, 0xd7, 0x1a, 0x75, 0x45, 0xdf, 0x57, 0x5b, 0xed, 0x06, 0x3a, 0xd1, 0x1b, 0x4d, 0x05, 0x55,
-	0xda, 0x6a, 0x43, 0xd3, 0xdb, 0x27, 0x4d, 0x45, 0x3f, 0xd2, 0x5a, 0x4d, 0xa5, 0xa6, 0x3e, 0x55,
-	0xc5, 0xd0, 0xe5, 0xd1, 0xb8, 0xb8, 0x75, 0x1d, 0xf6, 0x91, 0xc7, 0xfa, 0xd8, 0x72, 0x4e, 0x1d,
-	0x6c, 0xc3, 0x2f, 0xc0, 0x47, 0x37, 0x2a, 0xa3, 0x6a, 0x6a, 0x3b, 0x23, 0xe5, 0x36, 0x46, 0xe3,
-	0xe2, 0x07, 0xd7, 0xe1, 0xab, 0x9e, 0xc3, 0xe1, 0xd7, 0xe0, 0xe3, 0x1b, 0x01, 0x1f, 0xaa, 0x7b,
-	0xa8, 0xd2, 0x56, 0x32, 0x2b, 0xb9, 0xad, 0xd1, 0xb8, 0xf8, 0xe1, 0x75, 0xd8, 0x87, 0x4e, 0x87,
-	0x1a, 0x1c, 0xdf, 0x18, 0x7e, 0x4f, 0xd1, 0x94, 0x96, 0xda, 0xca, 0x44, 0x6f, 0x06, 0xbf, 0x87,
-	0x3d, 0xcc, 0x1c, 0x96, 0x8b, 0x05, 0xc7, 0xaa, 0x7e, 0xf5, 0xea, 0xf7, 0x7c, 0xe4, 0xe5, 0x34,
-	0x2f, 0xbd, 0x9a, 0xe6, 0xa5, 0xd7, 0xd3, 0xbc, 0xf4, 0xdb, 0x34, 0x2f, 0x7d, 0x7f, 0x91, 0x8f,
-	0xbc, 0xbe, 0xc8, 0x47, 0x7e, 0xbd, 0xc8, 0x47, 0xbe, 0xdc, 0x5d, 0xfa, 0x8a, 0x99, 0x45, 0xb9,
-	0x6b, 0x98, 0xac, 0xdc, 0x12, 0xe4, 0xd6, 0x30, 0xff, 0x86, 0xd0, 0xb3, 0xf2, 0xf0, 0xf2, 0xf7,
-	0x81, 0xe3, 0x71, 0x4c, 0x3d, 0xc3, 0x9d, 0xbd, 0xa2, 0x66, 0x5c, 0xfc, 0xcf, 0xff, 0xe4, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x45, 0x65, 0xf6, 0x47, 0x08, 0x00, 0x00,
+	// 1815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0x45, 0x5a, 0x12, 0x47, 0xb4, 0xcc, 0x4c, 0x64, 0x9b, 0x61, 0x13, 0x2e, 0xbd, 0x4e,
+	0x14, 0xc5, 0x8e, 0x45, 0xcb, 0xed, 0x21, 0x70, 0x4f, 0xfc, 0x58, 0xdb, 0x8c, 0x23, 0x92, 0x18,
+	0xd2, 0x36, 0x54, 0x24, 0x58, 0x2c, 0x77, 0x9f, 0xa8, 0x81, 0x96, 0xbb, 0xec, 0xce, 0x50, 0x21,
+	0x73, 0xea, 0xa1, 0x40, 0x0b, 0x9d, 0x7a, 0xec, 0x45, 0x40, 0x8b, 0x06, 0x45, 0x50, 0xa0, 0xff,
+	0x44, 0x4f, 0x3e, 0xe6, 0xd8, 0x13, 0xd1, 0xca, 0xa7, 0x9e, 0x0a, 0xf0, 0x98, 0x53, 0x31, 0xb3,
+	0xb3, 0xe4, 0x2a, 0x96, 0x2a, 0xd9, 0x28, 0x7a, 0xe2, 0xcc, 0xfb, 0xf8, 0xbd, 0x79, 0x6f, 0xde,
+	0xc7, 0x70, 0x91, 0xce, 0xc0, 0x0e, 0x80, 0x97, 0x6c, 0xbf, 0x3f, 0x18, 0x72, 0x28, 0x1d, 0x6e,
+	0x77, 0x81, 0x5b, 0xdb, 0x25, 0x3e, 0x1e, 0x00, 0xdb, 0x1a, 0x04, 0x3e, 0xf7, 0xf1, 0x8d, 0x50,
+	0x66, 0x4b, 0xc9, 0x6c, 0x29, 0x99, 0xfc, 0x7a, 0xcf, 0xef, 0xf9, 0x52, 0xa4, 0x24, 0x56, 0xa1,
+	0xb4, 0x6e, 0xa3, 0x6b, 0x65, 0xdb, 0x06, 0xc6, 0x3a, 0xe3, 0x01, 0xb4, 0xac, 0xc0, 0xea, 0xe3,
+	0xcf, 0xd1, 0x95, 0x43, 0xcb, 0x1d, 0x42, 0x2e, 0x51, 0x4c, 0x6c, 0xae, 0x3d, 0xd0, 0xb7, 0xce,
+	0x06, 0xdc, 0x9a, 0xeb, 0x55, 0xb2, 0xd3, 0x89, 0x96, 0x19, 0x5b, 0x7d, 0xf7, 0xa1, 0x2e, 0x55,
+	0x75, 0x12, 0x42, 0x3c, 0x4c, 0xfd, 0xfe, 0x0f, 0x5a, 0x42, 0xff, 0xe3, 0x12, 0x5a, 0x92, 0xd8,
+	0x0c, 0x77, 0x51, 0xbe, 0x6f, 0x8d, 0x4c, 0xdb, 0xf7, 0x78, 0x60, 0xd9, 0xdc, 0x64, 0xdc, 0x0f,
+	0xac, 0x1e, 0x98, 0xdd, 0x31, 0x07, 0x26, 0x2d, 0xa6, 0x2a, 0x1f, 0x4d, 0x27, 0xda, 0xad, 0x10,
+	0xed, 0x7c, 0x59, 0x9d, 0xdc, 0xec, 0x5b, 0xa3, 0xaa, 0xe2, 0xb5, 0x43, 0x56, 0x45, 0x70, 0x70,
+	0x1b, 0x5d, 0x3f, 0xa5, 0xd7, 0x67, 0x3d, 0x93, 0xd1, 0x6f, 0x20, 0xb7, 0x28, 0xe1, 0x8b, 0xd3,
+	0x89, 0xf6, 0xfe, 0x19, 0xf0, 0x91, 0x98, 0x4e, 0x70, 0x0c, 0x79, 0x87, 0xf5, 0xda, 0xf4, 0x1b,
+	0xc0, 0x2f, 0xd0, 0x0d, 0x15, 0x00, 0xb3, 0x67, 0x31, 0xb3, 0x3f, 0x74, 0x39, 0x1d, 0xb8, 0x14,
+	0x82, 0x5c, 0x52, 0xa2, 0xde, 0x9a, 0x4e, 0xb4, 0x0f, 0x42, 0xd4, 0xb3, 0xe5, 0x74, 0xb2, 0xae,
+	0x18, 0x8f, 0x2d, 0xb6, 0x33, 0x23, 0x0b, 0x60, 0xda, 0xb5, 0xcd, 0x00, 0x6c, 0xa0, 0x87, 0x10,
+	0x98, 0x5f, 0xef, 0x53, 0x0e, 0x2e, 0x65, 0x3c, 0x97, 0x2a, 0x26, 0x37, 0xd3, 0x71, 0xe0, 0xb3,
+	0xe5, 0x74, 0xb2, 0x4e, 0xbb, 0x36, 0x51, 0xf4, 0x17, 0x11, 0x39, 0x0a, 0x43, 0xd7, 0xf5, 0xed,
+	0x03, 0x33, 0x76, 0xa6, 0xdc, 0x95, 0xb3, 0xc2, 0xf0, 0x9a, 0x58, 0x18, 0x86, 0x8a, 0x20, 0x57,
+	0x67, 0xe7, 0xc6, 0xbf, 0x4e, 0xa0, 0x0d, 0x21, 0x4e, 0x3d, 0xc6, 0x2d, 0x8f, 0x53, 0x8b, 0x03,
+	0x33, 0x07, 0x10, 0x28, 0x7d, 0xb1, 0xb2, 0x6c, 0xdb, 0x1f, 0x7a, 0x3c, 0xb7, 0x24, 0xcd, 0x6c,
+	0x4f, 0x27, 0xda, 0xbd, 0xb9, 0x99, 0x8b, 0xf5, 0x74, 0x72, 0xab, 0x6f, 0x8d, 0xea, 0x31, 0xb9,
+	0x16, 0x04, 0xf2, 0x18, 0x2d, 0x08, 0xca, 0xa1, 0x0c, 0xfe, 0x0a, 0xe5, 0x62, 0x48, 0xa6, 0x4b,
+	0xfb, 0x94, 0x9b, 0x30, 0x82, 0xfe, 0x80, 0xe7, 0x96, 0x65, 0xd8, 0x6e, 0x4f, 0x27, 0x9a, 0xa6,
+	0xc2, 0x76, 0x8e, 0xa4, 0x4e, 0x6e, 0xc4, 0x58, 0x5f, 0x08, 0x8e, 0x21, 0x19, 0xf8, 0x97, 0x48,
+	0x8b, 0x92, 0xcd, 0x01, 0x17, 0x38, 0xf5, 0x3d, 0x33, 0x80, 0xbd, 0xa1, 0xe7, 0x88, 0x93, 0xda,
+	0xe0, 0xf1, 0xdc, 0x8a, 0xf4, 0xee, 0xce, 0x74, 0xa2, 0x6d, 0x84, 0x56, 0x2e, 0x50, 0xd0, 0xc9,
+	0xfb, 0x4a, 0xa2, 0xa6, 0x04, 0x88, 0xe4, 0xb7, 0x14, 0xfb, 0xaf, 0x8b, 0x28, 0x13, 0x56, 0x54,
+	0xd5, 0xf7, 0xf6, 0x68, 0x0f, 0xef, 0x22, 0x34, 0x80, 0xa0, 0x4f, 0x19, 0xa3, 0xbe, 0xf7, 0x06,
+	0xb5, 0x78, 0x7d, 0x3a, 0xd1, 0xde, 0x09, 0x8f, 0x34, 0xd7, 0xd7, 0x49, 0x0c, 0x0c, 0x7f, 0x85,
+	0x96, 0x2d, 0xc7, 0x09, 0x80, 0x31, 0x59, 0x12, 0x99, 0x4a, 0x75, 0x3a, 0xd1, 0xd6, 0x42, 0x1d,
+	0xc5, 0xd0, 0x7f, 0x98, 0x68, 0xf7, 0x7a, 0x94, 0xef, 0x0f, 0xbb, 0xc2, 0x58, 0xc9, 0xf6, 0x59,
+	0xdf, 0x67, 0xea, 0xe7, 0x1e, 0x73, 0x0e, 0x54, 0xcf, 0x29, 0xdb, 0x76, 0x39, 0xd4, 0x20, 0x11,
+	0x26, 0xb6, 0x51, 0x5a, 0x2d, 0x81, 0xe5, 0x92, 0xc5, 0xe4, 0x66, 0xa6, 0x62, 0x4c, 0x27, 0x5a,
+	0xf6, 0x94, 0x01, 0x78, 0x1b, 0x13, 0x73, 0x5c, 0x7d, 0x9a, 0x40, 0x99, 0x59, 0xf5, 0x5b, 0x2e,
+	0xc7, 0xb7, 0xd1, 0xb2, 0xed, 0x3b, 0x60, 0x52, 0x47, 0xb5, 0x11, 0x74, 0x32, 0xd1, 0x96, 0xaa,
+	0xbe, 0x03, 0xf5, 0x1a, 0x59, 0x12, 0xac, 0xba, 0x83, 0x9f, 0xa2, 0x65, 0x3b, 0x00, 0x8b, 0xfb,
+	0x81, 0xf2, 0x7c, 0xfb, 0x2d, 0xfc, 0x54, 0x08, 0x18, 0xa3, 0x14, 0xb3, 0x5c, 0x2e, 0x1b, 0x40,
+	0x86, 0xc8, 0x35, 0xfe, 0x12, 0x65, 0x67, 0x0d, 0x25, 0x8a, 0x71, 0xea, 0x6d, 0x2d, 0x5d, 0x8b,
+	0xa0, 0x14, 0x41, 0xff, 0xcb, 0x22, 0x5a, 0x91, 0x1e, 0x79, 0x7b, 0x3e, 0xfe, 0x09, 0x4a, 0x4b,
+	0x87, 0xf7, 0x2d, 0xb6, 0x2f, 0x5d, 0xce, 0x90, 0x15, 0x41, 0x78, 0x62, 0xb1, 0xfd, 0xff, 0xad,
+	0xa3, 0x37, 0xd0, 0x12, 0xf3, 0x87, 0x81, 0x0d, 0xd2, 0xd5, 0x34, 0x51, 0x3b, 0x9c, 0x43, 0xcb,
+	0xdd, 0x21, 0x75, 0x1d, 0x08, 0xa4, 0x8f, 0x69, 0x12, 0x6d, 0xf1, 0x2e, 0xc2, 0xf1, 0xaa, 0xb3,
+	0x65, 0x4a, 0xcb, 0xc6, 0xb3, 0xfa, 0xe0, 0xc3, 0xff, 0x9e, 0xc4, 0x61, 0xfa, 0x57, 0x52, 0x2f,
+	0x27, 0xda, 0x02, 0x79, 0x27, 0x86, 0xa2, 0xea, 0xe2, 0x63, 0x74, 0x2d, 0x34, 0x6f, 0xda, 0xfb,
+	0x60, 0x1f, 0xb0, 0x61, 0x5f, 0x76, 0x9a, 0x0c, 0x59, 0x0b, 0xc9, 0x55, 0x45, 0xd5, 0xbf, 0x4d,
+	0xa0, 0xd5, 0x28, 0x43, 0x9e, 0xc2, 0x18, 0x6f, 0xa0, 0x6b, 0x7e, 0x6f, 0xde, 0xee, 0x0f, 0x60,
+	0xac, 0xa2, 0x76, 0xd5, 0xef, 0xc5, 0xe5, 0xee, 0xa3, 0x75, 0x7b, 0x18, 0x04, 0xe0, 0xf1, 0xd3,
+	0xc2, 0x32, 0x8e, 0x04, 0x2b, 0x5e, 0x5c, 0xe3, 0xe7, 0x28, 0x7f, 0x96, 0x86, 0x39, 0x08, 0x7c,
+	0x7f, 0x4f, 0xa5, 0xc7, 0xcd, 0xd7, 0xf5, 0x5a, 0x82, 0xad, 0xff, 0x2a, 0x81, 0x70, 0x44, 0xac,
+	0x0e, 0x19, 0xf7, 0xfb, 0xf2, 0x76, 0x3b, 0x68, 0x15, 0x3c, 0xdb, 0xb5, 0x0e, 0x61, 0x76, 0xd2,
+	0xd5, 0x07, 0xb7, 0xcf, 0x0b, 0x5d, 0x0c, 0xb5, 0xb2, 0x76, 0x32, 0xd1, 0x90, 0x11, 0xea, 0x3e,
+	0x85, 0x31, 0x41, 0x30, 0x5b, 0xe3, 0x75, 0x74, 0xc5, 0xb5, 0xba, 0xe0, 0x4a, 0x67, 0xd2, 0x24,
+	0xdc, 0xe8, 0xbf, 0x49, 0xcd, 0x6b, 0x49, 0x1a, 0xff, 0xff, 0xd7, 0xd2, 0xec, 0x60, 0xa9, 0xd8,
+	0xc1, 0x70, 0x4d, 0x99, 0x00, 0x47, 0xe5, 0xce, 0x9d, 0x73, 0x73, 0xa7, 0xcb, 0x7c, 0x77, 0xc8,
+	0xa1, 0x33, 0x6a, 0xf9, 0x8c, 0xca, 0xf6, 0x1a, 0xa9, 0xe2, 0x7b, 0x68, 0x55, 0x4c, 0xce, 0x81,
+	0x1f, 0x70, 0xe1, 0x91, 0xc8, 0x96, 0x74, 0xe5, 0xea, 0xc9, 0x44, 0x4b, 0xd7, 0x2b, 0xd5, 0x96,
+	0x1f, 0xf0, 0x7a, 0x8d, 0xa4, 0x69, 0xd7, 0x96, 0x4b, 0x47, 0x1c, 0xc5, 0x72, 0xfa, 0xd4, 0xcb,
+	0x2d, 0x87, 0x47, 0x91, 0x1b, 0xac, 0xa1, 0x55, 0xb9, 0x50, 0x97, 0xba, 0x22, 0x2f, 0x15, 0x49,
+	0x92, 0xbc, 0x47, 0x51, 0x24, 0x03, 0x6b, 0xc8, 0xc0, 0xc9, 0xa5, 0x8b, 0x89, 0xcd, 0x15, 0xa2,
+	0x76, 0x78, 0x1b, 0xad, 0x07, 0x00, 0x22, 0xb8, 0x9e, 0x2d, 0x53, 0x82, 0x83, 0x2d, 0x1c, 0x42,
+	0x52, 0xea, 0xdd, 0x39, 0xaf, 0x15, 0xb1, 0x70, 0x01, 0x21, 0x2e, 0xba, 0xb5, 0x27, 0x3d, 0x5f,
+	0x95, 0x82, 0x31, 0x0a, 0xfe, 0x08, 0xad, 0x31, 0xf0, 0x9c, 0xd8, 0x53, 0x21, 0x23, 0x66, 0x1e,
+	0xb9, 0x2a, 0xa8, 0xf3, 0x07, 0xc0, 0x36, 0x5a, 0xef, 0xd3, 0x5e, 0x60, 0xc9, 0x69, 0xc4, 0x69,
+	0x1f, 0xc4, 0x10, 0x05, 0x27, 0x77, 0x35, 0xb4, 0x3c, 0xe3, 0x75, 0x66, 0x2c, 0x9d, 0x20, 0xfc,
+	0x7a, 0x24, 0xf1, 0x2d, 0x94, 0x09, 0xc7, 0xf4, 0x3e, 0xd0, 0xde, 0x3e, 0x97, 0x39, 0x91, 0x24,
+	0xab, 0x92, 0xf6, 0x44, 0x92, 0xf0, 0x7b, 0x68, 0x85, 0x8b, 0xe9, 0xee, 0xc0, 0x28, 0x7c, 0x66,
+	0x91, 0x65, 0x3e, 0xaa, 0x8b, 0xad, 0x4e, 0xd1, 0x95, 0x1d, 0xdf, 0x01, 0x17, 0x7f, 0x8e, 0x92,
+	0x4f, 0xa3, 0xa2, 0xab, 0x7c, 0xf6, 0xc3, 0x44, 0xfb, 0x59, 0x2c, 0x59, 0x38, 0x78, 0x8e, 0xf4,
+	0x90, 0xc7, 0x97, 0x2e, 0xed, 0xb2, 0x92, 0x7c, 0xef, 0x6d, 0x3d, 0x81, 0x91, 0x7c, 0xde, 0x91,
+	0xa4, 0x4a, 0xe4, 0xe7, 0xf2, 0x91, 0x1a, 0x56, 0x65, 0xb8, 0xd1, 0xff, 0x9d, 0x40, 0xb9, 0x59,
+	0x2d, 0x89, 0x56, 0x48, 0xc5, 0xcc, 0x1d, 0x1b, 0x1e, 0x0f, 0xc6, 0xf8, 0x39, 0x4a, 0xfb, 0x03,
+	0x08, 0x5d, 0x56, 0xf3, 0xf4, 0xb3, 0x8b, 0xea, 0x29, 0x06, 0xd2, 0x8c, 0x74, 0xc5, 0x94, 0x25,
+	0x73, 0xa8, 0x78, 0xb1, 0x2c, 0x9e, 0x5b, 0x2c, 0x35, 0xb4, 0x3c, 0x1c, 0x38, 0xf2, 0x3e, 0x93,
+	0x6f, 0x9e, 0xc9, 0x4a, 0x15, 0x67, 0x51, 0xb2, 0xcf, 0x7a, 0xe1, 0x40, 0x21, 0x62, 0xa9, 0x0f,
+	0xd0, 0xcd, 0xe8, 0xac, 0xc6, 0x08, 0xec, 0x61, 0xf8, 0xb0, 0x60, 0x43, 0x97, 0xe3, 0x4f, 0xce,
+	0x18, 0x45, 0x09, 0x99, 0xd2, 0x3f, 0x9e, 0x2b, 0x62, 0x92, 0x39, 0x16, 0xb7, 0x54, 0x30, 0xe5,
+	0x5a, 0xe4, 0xb3, 0xba, 0xee, 0xa4, 0xbc, 0x6e, 0xb5, 0xd3, 0x0f, 0xd1, 0x75, 0x79, 0xaf, 0xe0,
+	0x18, 0x87, 0xe0, 0xf1, 0x32, 0xe7, 0x01, 0xed, 0x0e, 0x39, 0xe0, 0x0f, 0x10, 0x02, 0x41, 0x31,
+	0x45, 0x95, 0x2b, 0x4b, 0x69, 0x49, 0x11, 0x11, 0x13, 0x67, 0x8f, 0xba, 0x68, 0x9a, 0x88, 0xa5,
+	0xb8, 0xc3, 0xf0, 0x8f, 0x46, 0x38, 0x55, 0xc2, 0x4d, 0xcc, 0x6e, 0xea, 0x94, 0xdd, 0xbf, 0x25,
+	0x50, 0xb6, 0x05, 0x9e, 0x43, 0xbd, 0xde, 0x4e, 0x94, 0xb9, 0x6f, 0xe2, 0xe3, 0xa5, 0xae, 0xe9,
+	0x53, 0x84, 0x07, 0x81, 0x3f, 0xf0, 0x19, 0x38, 0xa6, 0xc5, 0xcd, 0x53, 0x01, 0xc8, 0x46, 0x9c,
+	0x32, 0x57, 0x49, 0x7f, 0x1f, 0xad, 0x83, 0x0c, 0x3a, 0x98, 0xd6, 0x1e, 0x87, 0xc0, 0x3c, 0x75,
+	0x70, 0xac, 0x78, 0x65, 0xc1, 0x0a, 0x35, 0xee, 0xfc, 0x2b, 0x81, 0xd0, 0xfc, 0xad, 0x86, 0x37,
+	0x50, 0xfa, 0x59, 0xa3, 0x66, 0x3c, 0xaa, 0x37, 0x8c, 0x5a, 0x76, 0x21, 0x7f, 0xf3, 0xe8, 0xb8,
+	0xf8, 0xee, 0x9c, 0xfd, 0xcc, 0x73, 0x60, 0x8f, 0x7a, 0xe0, 0xe0, 0x22, 0x5a, 0x6a, 0x34, 0x2b,
+	0xcd, 0xda, 0x6e, 0x36, 0x91, 0x5f, 0x3f, 0x3a, 0x2e, 0x66, 0xe7, 0x42, 0x0d, 0xbf, 0xeb, 0x3b,
+	0x63, 0x7c, 0x17, 0x65, 0x9a, 0x8d, 0x2f, 0x76, 0xcd, 0x72, 0xad, 0x46, 0x8c, 0x76, 0x3b, 0xbb,
+	0x98, 0x7f, 0xef, 0xe8, 0xb8, 0x78, 0x7d, 0x2e, 0xd7, 0xf4, 0xdc, 0x71, 0x14, 0x8a, 0x0d, 0x94,
+	0x36, 0x9e, 0x1b, 0x64, 0x57, 0x22, 0x26, 0x7f, 0x6c, 0xd6, 0x38, 0x84, 0x60, 0x2c, 0x41, 0x1f,
+	0xa0, 0x6c, 0xb9, 0xb1, 0x6b, 0x36, 0x1f, 0x45, 0xb0, 0x46, 0x3b, 0x9b, 0xca, 0xbf, 0x7f, 0x74,
+	0x5c, 0xcc, 0xcd, 0xc5, 0xcb, 0xde, 0xb8, 0xb9, 0x57, 0x8e, 0xde, 0x65, 0xf9, 0x95, 0xdf, 0xfe,
+	0xa9, 0xb0, 0xf0, 0xdd, 0xb7, 0x85, 0x85, 0x3b, 0x7f, 0x4e, 0xa2, 0xe2, 0x45, 0x75, 0x84, 0x01,
+	0xdd, 0xaf, 0x36, 0x1b, 0x1d, 0x52, 0xae, 0x76, 0xcc, 0x6a, 0xb3, 0x66, 0x98, 0x4f, 0xea, 0xed,
+	0x4e, 0x93, 0xec, 0x9a, 0xcd, 0x96, 0x41, 0xca, 0x9d, 0x7a, 0xb3, 0x61, 0x76, 0x76, 0x5b, 0x86,
+	0xf9, 0xac, 0xd1, 0x6e, 0x19, 0xd5, 0xfa, 0xa3, 0xba, 0x0c, 0x54, 0xe9, 0xe8, 0xb8, 0x78, 0xf7,
+	0x22, 0xec, 0x67, 0x1e, 0x1b, 0x80, 0x4d, 0xf7, 0x28, 0x38, 0xf8, 0x05, 0xfa, 0xe4, 0x52, 0x66,
+	0xea, 0x8d, 0x7a, 0x27, 0x9b, 0xc8, 0x6f, 0x1e, 0x1d, 0x17, 0x3f, 0xbc, 0x08, 0xbf, 0xee, 0x51,
+	0xf1, 0x47, 0xe4, 0xd3, 0x4b, 0x01, 0xef, 0xd4, 0x1f, 0x93, 0x72, 0xc7, 0xc8, 0x2e, 0xe6, 0xef,
+	0x1e, 0x1d, 0x17, 0x3f, 0xbe, 0x08, 0x3b, 0xcc, 0x70, 0xb8, 0x34, 0xfc, 0x63, 0xa3, 0x61, 0xb4,
+	0xeb, 0xed, 0x6c, 0xf2, 0x72, 0xf0, 0x8f, 0xc1, 0x03, 0x46, 0x59, 0x3e, 0x25, 0x2e, 0xab, 0xf2,
+	0xe5, 0xcb, 0x7f, 0x16, 0x16, 0xbe, 0x3b, 0x29, 0x24, 0x5e, 0x9e, 0x14, 0x12, 0xdf, 0x9f, 0x14,
+	0x12, 0xff, 0x38, 0x29, 0x24, 0x7e, 0xf7, 0xaa, 0xb0, 0xf0, 0xfd, 0xab, 0xc2, 0xc2, 0xdf, 0x5f,
+	0x15, 0x16, 0x7e, 0xf1, 0x30, 0xd6, 0xa8, 0x99, 0x1d, 0x70, 0xd7, 0xea, 0xb2, 0x52, 0x5b, 0xf6,
+	0xaf, 0x06, 0xf0, 0xaf, 0xfd, 0xe0, 0xa0, 0x34, 0x9a, 0x7d, 0x94, 0xa0, 0x1e, 0x87, 0xc0, 0xb3,
+	0xdc, 0x70, 0xda, 0x77, 0x97, 0xe4, 0x87, 0x86, 0x9f, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe8,
+	0xeb, 0xdc, 0x19, 0xbc, 0x10, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -530,6 +886,129 @@ func (this *AccessTypeParam) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxContractStorageBytes != that1.MaxContractStorageBytes {
+		return false
+	}
+	if this.MaxContractMsgSize != that1.MaxContractMsgSize {
+		return false
+	}
+	if this.ComputeGasMultiplier != that1.ComputeGasMultiplier {
+		return false
+	}
+	if len(this.IbcReceiverWhitelist) != len(that1.IbcReceiverWhitelist) {
+		return false
+	}
+	for i := range this.IbcReceiverWhitelist {
+		if this.IbcReceiverWhitelist[i] != that1.IbcReceiverWhitelist[i] {
+			return false
+		}
+	}
+	if this.MaxBlockComputeGas != that1.MaxBlockComputeGas {
+		return false
+	}
+	if this.MaxInstantiatesPerBlockPerAccount != that1.MaxInstantiatesPerBlockPerAccount {
+		return false
+	}
+	if len(this.InstantiateLimitExempt) != len(that1.InstantiateLimitExempt) {
+		return false
+	}
+	for i := range this.InstantiateLimitExempt {
+		if this.InstantiateLimitExempt[i] != that1.InstantiateLimitExempt[i] {
+			return false
+		}
+	}
+	if this.StorageDeletionRefundPercent != that1.StorageDeletionRefundPercent {
+		return false
+	}
+	return true
+}
+func (this *AccessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessConfig)
+	if !ok {
+		that2, ok := that.(AccessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Permission != that1.Permission {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ContractSalt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractSalt)
+	if !ok {
+		that2, ok := that.(ContractSalt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !bytes.Equal(this.Creator, that1.Creator) {
+		return false
+	}
+	if !bytes.Equal(this.Salt, that1.Salt) {
+		return false
+	}
+	if !bytes.Equal(this.ContractAddress, that1.ContractAddress) {
+		return false
+	}
+	return true
+}
 func (this *CodeInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -561,6 +1040,12 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	if this.Builder != that1.Builder {
 		return false
 	}
+	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
+		return false
+	}
+	if !bytes.Equal(this.SourceChecksum, that1.SourceChecksum) {
+		return false
+	}
 	return true
 }
 func (this *ContractKey) Equal(that interface{}) bool {
@@ -660,6 +1145,26 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	if !bytes.Equal(this.AdminProof, that1.AdminProof) {
 		return false
 	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.ReentrancyProtected != that1.ReentrancyProtected {
+		return false
+	}
+	if this.Terminated != that1.Terminated {
+		return false
+	}
+	if len(this.SendWhitelist) != len(that1.SendWhitelist) {
+		return false
+	}
+	for i := range this.SendWhitelist {
+		if this.SendWhitelist[i] != that1.SendWhitelist[i] {
+			return false
+		}
+	}
+	if this.MigrationTimelocked != that1.MigrationTimelocked {
+		return false
+	}
 	return true
 }
 func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
@@ -749,9 +1254,105 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+func (this *ContractExecutionResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractExecutionResult)
+	if !ok {
+		that2, ok := that.(ContractExecutionResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *IndexedEventAttribute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IndexedEventAttribute)
+	if !ok {
+		that2, ok := that.(IndexedEventAttribute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EventType != that1.EventType {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *PendingMigration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingMigration)
+	if !ok {
+		that2, ok := that.(PendingMigration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if this.ProposedAtHeight != that1.ProposedAtHeight {
+		return false
+	}
+	if this.ExecuteAfterHeight != that1.ExecuteAfterHeight {
+		return false
+	}
+	return true
+}
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -777,6 +1378,170 @@ func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StorageDeletionRefundPercent != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StorageDeletionRefundPercent))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.InstantiateLimitExempt) > 0 {
+		for iNdEx := len(m.InstantiateLimitExempt) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InstantiateLimitExempt[iNdEx])
+			copy(dAtA[i:], m.InstantiateLimitExempt[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.InstantiateLimitExempt[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxInstantiatesPerBlockPerAccount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxInstantiatesPerBlockPerAccount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBlockComputeGas != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBlockComputeGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.IbcReceiverWhitelist) > 0 {
+		for iNdEx := len(m.IbcReceiverWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IbcReceiverWhitelist[iNdEx])
+			copy(dAtA[i:], m.IbcReceiverWhitelist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcReceiverWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ComputeGasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ComputeGasMultiplier))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxContractMsgSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractMsgSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxContractStorageBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractStorageBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractSalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -797,6 +1562,23 @@ func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SourceChecksum) > 0 {
+		i -= len(m.SourceChecksum)
+		copy(dAtA[i:], m.SourceChecksum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SourceChecksum)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.Builder) > 0 {
 		i -= len(m.Builder)
 		copy(dAtA[i:], m.Builder)
@@ -934,6 +1716,55 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MigrationTimelocked {
+		i--
+		if m.MigrationTimelocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.SendWhitelist) > 0 {
+		for iNdEx := len(m.SendWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SendWhitelist[iNdEx])
+			copy(dAtA[i:], m.SendWhitelist[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.SendWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Terminated {
+		i--
+		if m.Terminated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ReentrancyProtected {
+		i--
+		if m.ReentrancyProtected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.AdminProof) > 0 {
 		i -= len(m.AdminProof)
 		copy(dAtA[i:], m.AdminProof)
@@ -1111,51 +1942,278 @@ func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
-	}
-	return n
+
+func (m *ContractExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContractExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedEventAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedEventAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedEventAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteAfterHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProposedAtHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposedAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxContractStorageBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractStorageBytes))
+	}
+	if m.MaxContractMsgSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractMsgSize))
+	}
+	if m.ComputeGasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.ComputeGasMultiplier))
+	}
+	if len(m.IbcReceiverWhitelist) > 0 {
+		for _, s := range m.IbcReceiverWhitelist {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxBlockComputeGas != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBlockComputeGas))
+	}
+	if m.MaxInstantiatesPerBlockPerAccount != 0 {
+		n += 1 + sovTypes(uint64(m.MaxInstantiatesPerBlockPerAccount))
+	}
+	if len(m.InstantiateLimitExempt) > 0 {
+		for _, s := range m.InstantiateLimitExempt {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.StorageDeletionRefundPercent != 0 {
+		n += 1 + sovTypes(uint64(m.StorageDeletionRefundPercent))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractSalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.Builder)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.SourceChecksum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1230,6 +2288,24 @@ func (m *ContractInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
+	if m.ReentrancyProtected {
+		n += 2
+	}
+	if m.Terminated {
+		n += 2
+	}
+	if len(m.SendWhitelist) > 0 {
+		for _, s := range m.SendWhitelist {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MigrationTimelocked {
+		n += 2
+	}
 	return n
 }
 
@@ -1288,6 +2364,72 @@ func (m *ContractCodeHistoryEntry) Size() (n int) {
 	return n
 }
 
+func (m *ContractExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *IndexedEventAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.ProposedAtHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ProposedAtHeight))
+	}
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteAfterHeight))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1363,7 +2505,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1386,17 +2528,17 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractStorageBytes", wireType)
 			}
-			var byteLen int
+			m.MaxContractStorageBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1406,31 +2548,16 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxContractStorageBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractMsgSize", wireType)
 			}
-			var byteLen int
+			m.MaxContractMsgSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1440,29 +2567,33 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxContractMsgSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeGasMultiplier", wireType)
 			}
-			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
-			if m.Creator == nil {
-				m.Creator = []byte{}
+			m.ComputeGasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputeGasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcReceiverWhitelist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1490,11 +2621,49 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.IbcReceiverWhitelist = append(m.IbcReceiverWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockComputeGas", wireType)
+			}
+			m.MaxBlockComputeGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockComputeGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInstantiatesPerBlockPerAccount", wireType)
+			}
+			m.MaxInstantiatesPerBlockPerAccount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInstantiatesPerBlockPerAccount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateLimitExempt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1522,8 +2691,27 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Builder = string(dAtA[iNdEx:postIndex])
+			m.InstantiateLimitExempt = append(m.InstantiateLimitExempt, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeletionRefundPercent", wireType)
+			}
+			m.StorageDeletionRefundPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageDeletionRefundPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1545,7 +2733,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractKey) Unmarshal(dAtA []byte) error {
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1568,17 +2756,17 @@ func (m *ContractKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OgContractKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			var byteLen int
+			m.Permission = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1588,29 +2776,14 @@ func (m *ContractKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Permission |= AccessType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OgContractKey = append(m.OgContractKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.OgContractKey == nil {
-				m.OgContractKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentContractKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1637,14 +2810,14 @@ func (m *ContractKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentContractKey = append(m.CurrentContractKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentContractKey == nil {
-				m.CurrentContractKey = []byte{}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentContractKeyProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1671,10 +2844,8 @@ func (m *ContractKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentContractKeyProof = append(m.CurrentContractKeyProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentContractKeyProof == nil {
-				m.CurrentContractKeyProof = []byte{}
-			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1697,7 +2868,7 @@ func (m *ContractKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCustomInfo) Unmarshal(dAtA []byte) error {
+func (m *ContractSalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1720,17 +2891,36 @@ func (m *ContractCustomInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCustomInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractSalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCustomInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractSalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnclaveKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1740,33 +2930,65 @@ func (m *ContractCustomInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnclaveKey == nil {
-				m.EnclaveKey = &ContractKey{}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
 			}
-			if err := m.EnclaveKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1776,23 +2998,25 @@ func (m *ContractCustomInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = append(m.ContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractAddress == nil {
+				m.ContractAddress = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1815,7 +3039,7 @@ func (m *ContractCustomInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1838,17 +3062,17 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
-			m.CodeID = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1858,11 +3082,26 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
@@ -1897,9 +3136,9 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				m.Creator = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1927,13 +3166,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1943,33 +3182,1003 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChecksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChecksum = append(m.SourceChecksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.SourceChecksum == nil {
+				m.SourceChecksum = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OgContractKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OgContractKey = append(m.OgContractKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.OgContractKey == nil {
+				m.OgContractKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentContractKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentContractKey = append(m.CurrentContractKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentContractKey == nil {
+				m.CurrentContractKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentContractKeyProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentContractKeyProof = append(m.CurrentContractKeyProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentContractKeyProof == nil {
+				m.CurrentContractKeyProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCustomInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCustomInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCustomInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnclaveKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnclaveKey == nil {
+				m.EnclaveKey = &ContractKey{}
+			}
+			if err := m.EnclaveKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminProof = append(m.AdminProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.AdminProof == nil {
+				m.AdminProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReentrancyProtected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReentrancyProtected = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminated = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendWhitelist = append(m.SendWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationTimelocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MigrationTimelocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Model) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1979,29 +4188,35 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2011,27 +4226,31 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2058,9 +4277,9 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminProof = append(m.AdminProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.AdminProof == nil {
-				m.AdminProof = []byte{}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2084,7 +4303,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *ContractExecutionResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2107,17 +4326,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractExecutionResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2127,16 +4346,63 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.TxIndex = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2146,7 +4412,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2172,7 +4438,7 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Model) Unmarshal(dAtA []byte) error {
+func (m *IndexedEventAttribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,17 +4461,17 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexedEventAttribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexedEventAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2215,31 +4481,29 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2249,26 +4513,75 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2290,7 +4603,7 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *PendingMigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2313,17 +4626,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingMigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingMigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			m.Operation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2333,11 +4646,24 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
@@ -2358,10 +4684,10 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAtHeight", wireType)
 			}
-			var msglen int
+			m.ProposedAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2371,33 +4697,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposedAtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
 			}
-			var byteLen int
+			m.ExecuteAfterHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2407,26 +4716,11 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ExecuteAfterHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,12 +96,27 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *CodeInfo) { c.Builder = "invalid" },
 			expError:   true,
 		},
-		/*
-			"Instantiate config invalid": {
-				srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
-				expError:   true,
+		"source checksum set": {
+			srcMutator: func(c *CodeInfo) { c.SourceChecksum = bytes.Repeat([]byte{0x2}, 32) },
+		},
+		"source checksum not a sha256": {
+			srcMutator: func(c *CodeInfo) { c.SourceChecksum = bytes.Repeat([]byte{0x2}, 20) },
+			expError:   true,
+		},
+		"source checksum without source": {
+			srcMutator: func(c *CodeInfo) {
+				c.Source = ""
+				c.SourceChecksum = bytes.Repeat([]byte{0x2}, 32)
 			},
-		*/
+			expError: true,
+		},
+		"instantiate config undefined for legacy codes": {
+			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
+		},
+		"instantiate config invalid": {
+			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{Permission: AccessTypeOnlyAddress} },
+			expError:   true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -111,3 +130,70 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessConfigAllowed(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	otherAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+
+	specs := map[string]struct {
+		config AccessConfig
+		exp    bool
+	}{
+		"everybody":                 {config: AllowEverybody, exp: true},
+		"nobody":                    {config: AllowNobody, exp: false},
+		"undefined":                 {config: AccessConfig{}, exp: false},
+		"only address - matching":   {config: OnlyAddressAccess(myAddr), exp: true},
+		"only address - other":      {config: OnlyAddressAccess(otherAddr), exp: false},
+		"any of addresses - member": {config: AnyOfAddressesAccess(otherAddr, myAddr), exp: true},
+		"any of addresses - absent": {config: AnyOfAddressesAccess(otherAddr), exp: false},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			require.Equal(t, spec.exp, spec.config.Allowed(myAddr))
+		})
+	}
+}
+
+func TestAccessConfigValidateBasic(t *testing.T) {
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+
+	specs := map[string]struct {
+		config   AccessConfig
+		expError bool
+	}{
+		"everybody":                   {config: AllowEverybody},
+		"nobody":                      {config: AllowNobody},
+		"only address":                {config: OnlyAddressAccess(myAddr)},
+		"any of addresses":            {config: AnyOfAddressesAccess(myAddr)},
+		"undefined":                   {config: AccessConfig{}, expError: true},
+		"unknown permission":          {config: AccessConfig{Permission: 100}, expError: true},
+		"everybody with address":      {config: AccessConfig{Permission: AccessTypeEverybody, Address: myAddr}, expError: true},
+		"only address without one":    {config: AccessConfig{Permission: AccessTypeOnlyAddress}, expError: true},
+		"any of addresses empty":      {config: AnyOfAddressesAccess(), expError: true},
+		"any of addresses duplicated": {config: AnyOfAddressesAccess(myAddr, myAddr), expError: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got := spec.config.ValidateBasic()
+			if spec.expError {
+				require.Error(t, got)
+				return
+			}
+			require.NoError(t, got)
+		})
+	}
+}
+
+func TestAccessConfigJSONRoundTrip(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	myAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+
+	for _, config := range []AccessConfig{AllowEverybody, AllowNobody, OnlyAddressAccess(myAddr), AnyOfAddressesAccess(myAddr)} {
+		bz, err := cdc.MarshalJSON(&config)
+		require.NoError(t, err)
+
+		var got AccessConfig
+		require.NoError(t, cdc.UnmarshalJSON(bz, &got))
+		require.True(t, config.Equal(&got), "%s", bz)
+	}
+}
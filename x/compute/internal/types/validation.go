@@ -1,9 +1,11 @@
 package types
 
 import (
+	"crypto/sha256"
 	"net/url"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -27,6 +29,37 @@ const (
 	BuildTagRegexp = "^[a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+:[a-zA-Z0-9_][a-zA-Z0-9_.-]*$"
 
 	MaxBuildTagSize = 128
+
+	// MaxSaltSize is the longest salt that can be used when instantiating a contract with a predictable address
+	MaxSaltSize = 64
+
+	// MaxContractMsgSize is the largest encrypted init or execute message accepted by ValidateBasic.
+	// The MaxContractMsgSize param can lower it further.
+	MaxContractMsgSize = 256 * 1024 // 256KB
+
+	// MaxCorrelationIDSize is the longest correlation id an execution can be tagged with
+	MaxCorrelationIDSize = 128
+
+	// ExecutionResultRetention is the number of blocks the result of a correlated execution is
+	// kept for, about a week with 6 second blocks
+	ExecutionResultRetention = 100_000
+
+	// MaxIndexedEventAttributes is the number of event attributes indexed per contract. Once a
+	// contract has that many, indexing another attribute drops the oldest one.
+	MaxIndexedEventAttributes = 10_000
+
+	// MaxIndexedEventAttributeKeySize is the longest attribute key (without the prefix) that is indexed
+	MaxIndexedEventAttributeKeySize = 64
+
+	// MaxIndexedEventAttributeValueSize is the longest attribute value that is indexed
+	MaxIndexedEventAttributeValueSize = 256
+
+	// MaxSendWhitelistSize is the number of addresses a contract's send whitelist can hold
+	MaxSendWhitelistSize = 100
+
+	// MinMigrationDelay is the shortest challenge window of a proposed migration, in blocks, so
+	// users have time to notice it
+	MinMigrationDelay = 100
 )
 
 func validateSourceURL(source string) error {
@@ -45,6 +78,21 @@ func validateSourceURL(source string) error {
 	return nil
 }
 
+// validateSourceChecksum checks an optional sha256 checksum of the source code, which is only
+// meaningful together with the source it was taken of
+func validateSourceChecksum(checksum []byte, source string) error {
+	if len(checksum) == 0 {
+		return nil
+	}
+	if len(checksum) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "must be a %d byte sha256 checksum", sha256.Size)
+	}
+	if source == "" {
+		return sdkerrors.Wrap(ErrInvalid, "requires a source")
+	}
+	return nil
+}
+
 func validateBuilder(buildTag string) error {
 	if len(buildTag) > MaxBuildTagSize {
 		return sdkerrors.Wrap(ErrLimit, "longer than 128 characters")
@@ -78,3 +126,42 @@ func validateLabel(label string) error {
 	}
 	return nil
 }
+
+func validateSalt(salt []byte) error {
+	if len(salt) > MaxSaltSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxSaltSize)
+	}
+	return nil
+}
+
+func validateCorrelationID(correlationID string) error {
+	if len(correlationID) > MaxCorrelationIDSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxCorrelationIDSize)
+	}
+	return nil
+}
+
+func validateSendWhitelist(whitelist []string) error {
+	if len(whitelist) > MaxSendWhitelistSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot hold more than %d addresses", MaxSendWhitelistSize)
+	}
+	seen := make(map[string]struct{}, len(whitelist))
+	for _, addr := range whitelist {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrap(err, addr)
+		}
+		if _, ok := seen[addr]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateContractMsgSize returns an error if an encrypted contract message is larger than limit
+func ValidateContractMsgSize(msg []byte, limit uint64) error {
+	if uint64(len(msg)) > limit {
+		return sdkerrors.Wrapf(ErrLimit, "contract message of %d bytes exceeds the limit of %d bytes", len(msg), limit)
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package simulation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+
+	"github.com/miscreant/miscreant.go"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgStoreCode           = "op_weight_msg_store_code"           //nolint:gosec
+	OpWeightMsgInstantiateContract = "op_weight_msg_instantiate_contract" //nolint:gosec
+	OpWeightMsgExecuteContract     = "op_weight_msg_execute_contract"     //nolint:gosec
+
+	DefaultWeightMsgStoreCode           = 5
+	DefaultWeightMsgInstantiateContract = 20
+	DefaultWeightMsgExecuteContract     = 50
+)
+
+// ParamIoMasterKey is the simulation param holding the enclave's IO key, base64 encoded like in
+// the io-master-key.txt file secretcli writes. Without it no message can be encrypted, so no
+// contract is instantiated or executed.
+const ParamIoMasterKey = "io_master_key"
+
+// erc20Wasm is the contract the operations store, instantiate and execute. It's a copy of the
+// erc20 contract of the keeper tests, which works with plain json messages and any funded account.
+//
+//go:embed testdata/erc20.wasm
+var erc20Wasm []byte
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgStoreCode, weightMsgInstantiateContract, weightMsgExecuteContract int
+	appParams.GetOrGenerate(cdc, OpWeightMsgStoreCode, &weightMsgStoreCode, nil,
+		func(_ *rand.Rand) {
+			weightMsgStoreCode = DefaultWeightMsgStoreCode
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgInstantiateContract, &weightMsgInstantiateContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgInstantiateContract = DefaultWeightMsgInstantiateContract
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgExecuteContract, &weightMsgExecuteContract, nil,
+		func(_ *rand.Rand) {
+			weightMsgExecuteContract = DefaultWeightMsgExecuteContract
+		},
+	)
+
+	var ioMasterKey string
+	appParams.GetOrGenerate(cdc, ParamIoMasterKey, &ioMasterKey, nil,
+		func(_ *rand.Rand) {
+			ioMasterKey = ""
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgStoreCode,
+			SimulateMsgStoreCode(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgInstantiateContract,
+			SimulateMsgInstantiateContract(ak, bk, k, ioMasterKey),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgExecuteContract,
+			SimulateMsgExecuteContract(ak, bk, k, ioMasterKey),
+		),
+	}
+}
+
+// SimulateMsgStoreCode generates a MsgStoreCode of the erc20 contract from a random funded account
+func SimulateMsgStoreCode(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, ok := randomFundedAccount(r, ctx, bk, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgStoreCode{}.Type(), "no funded accounts"), nil, nil
+		}
+
+		msg := &types.MsgStoreCode{
+			Sender:       simAccount.Address,
+			WASMByteCode: erc20Wasm,
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgInstantiateContract generates a MsgInstantiateContract of a random stored erc20
+// code from a random funded account, which receives the contract's whole initial supply. The
+// init message is encrypted with ioMasterKey, see ParamIoMasterKey.
+func SimulateMsgInstantiateContract(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper, ioMasterKey string) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgInstantiateContract{}.Type()
+		if ioMasterKey == "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no io master key"), nil, nil
+		}
+
+		simAccount, ok := randomFundedAccount(r, ctx, bk, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no funded accounts"), nil, nil
+		}
+
+		codeIDs := erc20CodeIDs(ctx, k)
+		if len(codeIDs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no erc20 code stored"), nil, nil
+		}
+		codeID := codeIDs[r.Intn(len(codeIDs))]
+
+		codeInfo, err := k.GetCodeInfo(ctx, codeID)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+
+		initMsg := fmt.Sprintf(
+			`{"decimals":6,"initial_balances":[{"address":"%s","amount":"%d"}],"name":"SimCoin","symbol":"SIM"}`,
+			simAccount.Address, simtypes.RandIntBetween(r, 1, 1_000_000),
+		)
+		encryptedMsg, err := encryptMsg(r, ioMasterKey, codeInfo.CodeHash, []byte(initMsg))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to encrypt the init msg"), nil, err
+		}
+
+		msg := &types.MsgInstantiateContract{
+			Sender:  simAccount.Address,
+			CodeID:  codeID,
+			Label:   simtypes.RandStringOfLength(r, 20),
+			InitMsg: encryptedMsg,
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgExecuteContract generates a MsgExecuteContract to a random erc20 contract from a
+// random funded account, approving another random account to spend its tokens. The execute
+// message is encrypted with ioMasterKey, see ParamIoMasterKey.
+func SimulateMsgExecuteContract(ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, k keeper.Keeper, ioMasterKey string) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgExecuteContract{}.Type()
+		if ioMasterKey == "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no io master key"), nil, nil
+		}
+
+		simAccount, ok := randomFundedAccount(r, ctx, bk, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no funded accounts"), nil, nil
+		}
+
+		codeIDs := erc20CodeIDs(ctx, k)
+		var contracts []sdk.AccAddress
+		k.IterateContractInfo(ctx, func(addr sdk.AccAddress, info types.ContractInfo, _ types.ContractCustomInfo) bool {
+			for _, codeID := range codeIDs {
+				if info.CodeID == codeID {
+					contracts = append(contracts, addr)
+					break
+				}
+			}
+			return false
+		})
+		if len(contracts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no erc20 contracts instantiated"), nil, nil
+		}
+		contractAddress := contracts[r.Intn(len(contracts))]
+
+		codeHash, err := k.GetContractHash(ctx, contractAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, err
+		}
+
+		spender, _ := simtypes.RandomAcc(r, accs)
+		execMsg := fmt.Sprintf(`{"approve":{"spender":"%s","amount":"%d"}}`, spender.Address, simtypes.RandIntBetween(r, 1, 1_000_000))
+		encryptedMsg, err := encryptMsg(r, ioMasterKey, codeHash, []byte(execMsg))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to encrypt the execute msg"), nil, err
+		}
+
+		msg := &types.MsgExecuteContract{
+			Sender:   simAccount.Address,
+			Contract: contractAddress,
+			Msg:      encryptedMsg,
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg)
+	}
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak authkeeper.AccountKeeper, bk bankkeeper.Keeper, simAccount simtypes.Account, msg legacytx.LegacyMsg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randomFundedAccount returns a random account out of accs that can pay fees, false if there is none
+func randomFundedAccount(r *rand.Rand, ctx sdk.Context, bk bankkeeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		if !bk.SpendableCoins(ctx, accs[i].Address).IsZero() {
+			return accs[i], true
+		}
+	}
+	return simtypes.Account{}, false
+}
+
+// erc20CodeIDs returns the ids of the codes SimulateMsgStoreCode stored
+func erc20CodeIDs(ctx sdk.Context, k keeper.Keeper) []uint64 {
+	codeHash := sha256.Sum256(erc20Wasm)
+
+	var codeIDs []uint64
+	k.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		if bytes.Equal(info.CodeHash, codeHash[:]) {
+			codeIDs = append(codeIDs, codeID)
+		}
+		return false
+	})
+	return codeIDs
+}
+
+// encryptMsg encrypts msg for a contract of the code with codeHash the way secretcli does. It
+// uses ioMasterKey, the base64 encoded IO key of the enclave, and draws the tx encryption key
+// pair and nonce from r so runs with the same seed generate the same txs.
+func encryptMsg(r *rand.Rand, ioMasterKey string, codeHash []byte, msg []byte) ([]byte, error) {
+	ioKey, err := base64.StdEncoding.DecodeString(ioMasterKey)
+	if err != nil {
+		return nil, fmt.Errorf("%s simulation param: %w", ParamIoMasterKey, err)
+	}
+
+	var privKey, pubKey [32]byte
+	r.Read(privKey[:])
+	curve25519.ScalarBaseMult(&pubKey, &privKey)
+
+	nonce := make([]byte, 32)
+	r.Read(nonce)
+
+	txEncryptionKey, err := utils.GetTxEncryptionKeyOffline(ioKey, privKey[:], nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	cipher, err := miscreant.NewAESCMACSIV(txEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	secretMsg := types.SecretMsg{
+		CodeHash: []byte(hex.EncodeToString(codeHash)),
+		Msg:      msg,
+	}
+	ciphertext, err := cipher.Seal(nil, secretMsg.Serialize(), []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	// nonce(32) || tx_sender_pubkey(32) || ciphertext
+	return append(append(nonce, pubKey[:]...), ciphertext...), nil
+}
@@ -0,0 +1,77 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"math/rand"
+	"testing"
+
+	"github.com/miscreant/miscreant.go"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestErc20WasmEmbedded(t *testing.T) {
+	require.True(t, bytes.HasPrefix(erc20Wasm, []byte("\x00asm")))
+}
+
+func TestEncryptMsg(t *testing.T) {
+	var ioPrivKey, ioPubKey [32]byte
+	rand.New(rand.NewSource(1)).Read(ioPrivKey[:])
+	curve25519.ScalarBaseMult(&ioPubKey, &ioPrivKey)
+	ioMasterKey := base64.StdEncoding.EncodeToString(ioPubKey[:])
+
+	codeHash := bytes.Repeat([]byte{0xab}, 32)
+	msg := []byte(`{"approve":{}}`)
+
+	encrypted, err := encryptMsg(rand.New(rand.NewSource(2)), ioMasterKey, codeHash, msg)
+	require.NoError(t, err)
+
+	// the same seed gives the same message
+	again, err := encryptMsg(rand.New(rand.NewSource(2)), ioMasterKey, codeHash, msg)
+	require.NoError(t, err)
+	require.Equal(t, encrypted, again)
+
+	// the enclave derives the same key from its private key and the tx sender's public key
+	nonce, txSenderPubKey, ciphertext := encrypted[:32], encrypted[32:64], encrypted[64:]
+	txEncryptionKey, err := utils.GetTxEncryptionKeyOffline(txSenderPubKey, ioPrivKey[:], nonce)
+	require.NoError(t, err)
+	cipher, err := miscreant.NewAESCMACSIV(txEncryptionKey)
+	require.NoError(t, err)
+	plaintext, err := cipher.Open(nil, ciphertext, []byte{})
+	require.NoError(t, err)
+	require.Equal(t, types.SecretMsg{CodeHash: []byte(hex.EncodeToString(codeHash)), Msg: msg}.Serialize(), plaintext)
+
+	_, err = encryptMsg(rand.New(rand.NewSource(2)), "not base64!", codeHash, msg)
+	require.Error(t, err)
+}
+
+func TestWeightedOperations(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	ops := WeightedOperations(simtypes.AppParams{}, cdc, authkeeper.AccountKeeper{}, nil, keeper.Keeper{})
+	require.Len(t, ops, 3)
+	require.Equal(t, DefaultWeightMsgStoreCode, ops[0].Weight())
+	require.Equal(t, DefaultWeightMsgInstantiateContract, ops[1].Weight())
+	require.Equal(t, DefaultWeightMsgExecuteContract, ops[2].Weight())
+
+	// without an io master key no contract message can be encrypted
+	for _, op := range ops[1:] {
+		opMsg, futureOps, err := op.Op()(rand.New(rand.NewSource(1)), nil, sdk.Context{}, nil, "")
+		require.NoError(t, err)
+		require.False(t, opMsg.OK)
+		require.Equal(t, "no io master key", opMsg.Comment)
+		require.Empty(t, futureOps)
+	}
+}
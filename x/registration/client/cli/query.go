@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -8,6 +9,8 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/scrtlabs/SecretNetwork/types/util"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/keeper"
 	flag "github.com/spf13/pflag"
 
@@ -29,10 +32,51 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdEncryptedSeed(),
 		GetCmdMasterParams(),
+		GetCmdIoExchangePubKey(),
+		GetCmdListRegisteredNodes(),
+		GetCmdVerifyAttestation(),
+		GetCmdEnclaveHealth(),
 	)
 	return queryCmd
 }
 
+// GetCmdListRegisteredNodes lists the public keys of all registered nodes
+func GetCmdListRegisteredNodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-nodes",
+		Short: "List all registered enclave nodes",
+		Long:  "List the public key and registration height of every node registered on chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := util.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RegisteredNodes(
+				context.Background(),
+				&types.QueryRegisteredNodesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "registered nodes")
+	return cmd
+}
+
 // GetCmdListCode lists all wasm code uploaded
 func GetCmdEncryptedSeed() *cobra.Command {
 	cmd := &cobra.Command{
@@ -107,6 +151,98 @@ func GetCmdMasterParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdIoExchangePubKey prints the consensus IO exchange public key
+func GetCmdIoExchangePubKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "io-exchange-pub-key",
+		Short: "Get the consensus IO exchange public key",
+		Long: "Get the consensus IO exchange public key used to encrypt contract messages, its sha256 fingerprint and " +
+			"expected length. A cached key is stale when its fingerprint differs from the one returned",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.IoExchangePubKey(context.Background(), &empty.Empty{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdVerifyAttestation checks an attestation certificate without registering the node
+func GetCmdVerifyAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-attestation [attestation-cert-file]",
+		Short: "Check the attestation report of a certificate without registering the node",
+		Long: "Check the attestation report of a certificate like node registration does, without registering it. Prints which check failed, if any. " +
+			"The MRSIGNER and TCB level checks run inside the enclave when the node registers, so they aren't covered",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cert, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VerifyAttestation(
+				context.Background(),
+				&types.QueryVerifyAttestationRequest{
+					Certificate: cert,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdEnclaveHealth checks the enclave of the queried node
+func GetCmdEnclaveHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enclave-health",
+		Short: "Check the enclave of the queried node",
+		Long: "Run a round-trip to the enclave of the queried node and print its status, the node version " +
+			"and whether the enclave sealed the key pair it registers the node with. This is a diagnostic of the node " +
+			"answering the query, point --node at the node to check",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EnclaveHealth(context.Background(), &empty.Empty{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
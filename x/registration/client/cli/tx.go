@@ -1,15 +1,21 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
+	"io"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 	"github.com/spf13/cobra"
 )
 
+const flagDryRun = "dry-run"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -30,8 +36,23 @@ func AuthenticateNodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "auth [cert file]",
 		Short: "Upload a certificate to authenticate the node",
-		Args:  cobra.ExactArgs(1),
+		Long: `Upload a certificate to authenticate the node.
+
+With --dry-run the certificate is only verified locally and the node public key it carries is
+printed, nothing is signed or broadcast. Only the attestation report is verified: the enclave
+checks, such as the MRSIGNER and TCB checks and the encryption of the seed for the node, aren't
+covered and only run when the registration is processed on chain.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			dryRun, err := cmd.Flags().GetBool(flagDryRun)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				_, err := dryRunAuthenticate(cmd.OutOrStdout(), args[0])
+				return err
+			}
+
 			// clientCtx := client.GetClientContextFromCmd(cmd)
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -56,7 +77,34 @@ func AuthenticateNodeCmd() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Bool(flagDryRun, false, "Verify the certificate locally and print the node public key, without building or broadcasting the transaction")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// dryRunAuthenticate runs the checks that don't need the enclave on the certificate in certFile,
+// writes the result of each one to w and returns the node public key
+func dryRunAuthenticate(w io.Writer, certFile string) ([]byte, error) {
+	cert, err := os.ReadFile(certFile)
+	if err != nil {
+		fmt.Fprintf(w, "read certificate: failed: %s\n", err)
+		return nil, err
+	}
+	if len(cert) == 0 {
+		err = fmt.Errorf("certificate file %s is empty", certFile)
+		fmt.Fprintf(w, "read certificate: failed: %s\n", err)
+		return nil, err
+	}
+	fmt.Fprintf(w, "read certificate: ok (%d bytes)\n", len(cert))
+
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	if err != nil {
+		fmt.Fprintf(w, "verify attestation report: failed: %s\n", err)
+		return nil, err
+	}
+	fmt.Fprintln(w, "verify attestation report: ok")
+	fmt.Fprintf(w, "node public key: %s\n", hex.EncodeToString(publicKey))
+
+	return publicKey, nil
+}
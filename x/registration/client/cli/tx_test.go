@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunAuthenticate(t *testing.T) {
+	t.Setenv("SGX_MODE", "SW")
+
+	emptyFile := filepath.Join(t.TempDir(), "empty")
+	require.NoError(t, os.WriteFile(emptyFile, nil, 0o600))
+
+	specs := map[string]struct {
+		certFile  string
+		expOutput []string
+		expError  bool
+	}{
+		"valid certificate": {
+			certFile:  "../../testdata/attestation_cert_sw.combined",
+			expOutput: []string{"read certificate: ok", "verify attestation report: ok", "node public key: "},
+		},
+		"malformed certificate": {
+			certFile:  "../../testdata/attestation_cert_invalid",
+			expOutput: []string{"read certificate: ok", "verify attestation report: failed"},
+			expError:  true,
+		},
+		"empty file": {
+			certFile:  emptyFile,
+			expOutput: []string{"read certificate: failed"},
+			expError:  true,
+		},
+		"missing file": {
+			certFile:  filepath.Join(t.TempDir(), "missing"),
+			expOutput: []string{"read certificate: failed"},
+			expError:  true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			var out bytes.Buffer
+			publicKey, err := dryRunAuthenticate(&out, spec.certFile)
+			if spec.expError {
+				require.Error(t, err)
+				require.Nil(t, publicKey)
+			} else {
+				require.NoError(t, err)
+				require.NotEmpty(t, publicKey)
+			}
+			for _, line := range spec.expOutput {
+				require.Contains(t, out.String(), line)
+			}
+		})
+	}
+}
@@ -17,3 +17,7 @@ func (Api) GetEncryptedSeed(masterCert []byte) ([]byte, error) {
 func (Api) GetEncryptedGenesisSeed(pk []byte) ([]byte, error) {
 	return api.GetEncryptedGenesisSeed(pk)
 }
+
+func (Api) HealthCheck() ([]byte, error) {
+	return api.HealthCheck()
+}
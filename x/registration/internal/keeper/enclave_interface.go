@@ -4,4 +4,5 @@ type EnclaveInterface interface {
 	LoadSeed(masterKey []byte, seed []byte, apiKey []byte) (bool, error)
 	GetEncryptedSeed(masterCert []byte) ([]byte, error)
 	GetEncryptedGenesisSeed(pk []byte) ([]byte, error)
+	HealthCheck() ([]byte, error)
 }
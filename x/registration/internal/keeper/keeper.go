@@ -22,6 +22,7 @@ type Keeper struct {
 	cdc      codec.BinaryCodec
 	enclave  EnclaveInterface
 	router   sdk.Router
+	homeDir  string
 }
 
 // NewKeeper creates a new contract Keeper instance
@@ -35,6 +36,7 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, router sdk.Router,
 		cdc:      cdc,
 		router:   router,
 		enclave:  enclave,
+		homeDir:  homeDir,
 	}
 }
 
@@ -161,12 +163,7 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 	}
 
 	if !fileExists(legacySeedPath) {
-		sgxSecretsFolder := os.Getenv("SCRT_SGX_STORAGE")
-		if sgxSecretsFolder == "" {
-			sgxSecretsFolder = os.ExpandEnv("/opt/secret/.sgx_secrets")
-		}
-
-		sgxAttestationCertPath := filepath.Join(sgxSecretsFolder, types.AttestationCertPath)
+		sgxAttestationCertPath := filepath.Join(sgxSecretsFolder(), types.AttestationCertPath)
 		if !fileExists(sgxAttestationCertPath) {
 			fmt.Printf("Failed to create legacy seed file. Attestation certificate does not exist in %s. Try to re-initialize the enclave\n", sgxAttestationCertPath)
 			return
@@ -189,6 +186,20 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 	}
 }
 
+// sgxSecretsFolder returns the folder the enclave keeps its sealed files in
+func sgxSecretsFolder() string {
+	folder := os.Getenv("SCRT_SGX_STORAGE")
+	if folder == "" {
+		folder = os.ExpandEnv("/opt/secret/.sgx_secrets")
+	}
+	return folder
+}
+
+// hasRegistrationKey reports whether the enclave sealed the key pair it registers the node with
+func hasRegistrationKey() bool {
+	return fileExists(filepath.Join(sgxSecretsFolder(), types.EnclaveRegistrationKey))
+}
+
 func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byte, error) {
 	// fmt.Println("RegisterNode")
 	var encSeed []byte
@@ -223,8 +234,9 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 	fmt.Println("Done RegisterNode")
 	fmt.Println("Got seed: ", hex.EncodeToString(encSeed))
 	regInfo := types.RegistrationNodeInfo{
-		Certificate:   certificate,
-		EncryptedSeed: encSeed,
+		Certificate:        certificate,
+		EncryptedSeed:      encSeed,
+		RegistrationHeight: ctx.BlockHeight(),
 	}
 
 	if isSimulationMode(ctx) {
@@ -236,6 +248,19 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 	return encSeed, nil
 }
 
+// VerifyAttestation verifies the attestation report of a certificate, like RegisterNode does before it
+// asks the enclave for the seed, without registering the node. It never calls the enclave: it serves a
+// public query, and the seed must only be exported for a registration. It returns the node public key
+// found in the certificate and, if the check failed, which one.
+func (k Keeper) VerifyAttestation(certificate ra.Certificate) ([]byte, types.AttestationCheck, error) {
+	publicKey, err := ra.VerifyCombinedCert(certificate)
+	if err != nil {
+		return nil, types.AttestationCheckCertificate, err
+	}
+
+	return publicKey, types.AttestationCheckNone, nil
+}
+
 // returns true when simulation mode used by gas=auto queries
 func isSimulationMode(ctx sdk.Context) bool {
 	return ctx.GasMeter().Limit() == 0 && ctx.BlockHeight() != 0
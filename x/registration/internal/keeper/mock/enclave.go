@@ -15,3 +15,7 @@ func (MockEnclaveApi) GetEncryptedSeed(_ []byte) ([]byte, error) {
 func (MockEnclaveApi) GetEncryptedGenesisSeed(_ []byte) ([]byte, error) {
 	return []byte(""), nil
 }
+
+func (MockEnclaveApi) HealthCheck() ([]byte, error) {
+	return []byte(""), nil
+}
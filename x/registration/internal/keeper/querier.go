@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 
 	"github.com/golang/protobuf/ptypes/empty"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 )
 
@@ -46,6 +51,20 @@ func (q GrpcQuerier) RegistrationKey(c context.Context, _ *empty.Empty) (*types.
 	}, nil
 }
 
+func (q GrpcQuerier) IoExchangePubKey(c context.Context, _ *empty.Empty) (*types.QueryIoExchangePubKeyResponse, error) {
+	ioKey := q.keeper.GetMasterKey(sdk.UnwrapSDKContext(c), types.MasterIoKeyId)
+	if ioKey == nil {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, "Chain has not been initialized yet")
+	}
+
+	fingerprint := sha256.Sum256(ioKey.Bytes)
+	return &types.QueryIoExchangePubKeyResponse{
+		Key:         ioKey.Bytes,
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+		KeyLength:   types.IoExchangePubKeyLength,
+	}, nil
+}
+
 func (q GrpcQuerier) EncryptedSeed(c context.Context, req *types.QueryEncryptedSeedRequest) (*types.QueryEncryptedSeedResponse, error) {
 	if req.PubKey == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "public key")
@@ -60,6 +79,49 @@ func (q GrpcQuerier) EncryptedSeed(c context.Context, req *types.QueryEncryptedS
 	return &types.QueryEncryptedSeedResponse{EncryptedSeed: rsp}, nil
 }
 
+func (q GrpcQuerier) RegisteredNodes(c context.Context, req *types.QueryRegisteredNodesRequest) (*types.QueryRegisteredNodesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nodes := make([]types.RegisteredNode, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.RegistrationStorePrefix)
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var regInfo types.RegistrationNodeInfo
+		if err := q.keeper.cdc.Unmarshal(value, &regInfo); err != nil {
+			return err
+		}
+		nodes = append(nodes, types.RegisteredNode{
+			PubKey:             key,
+			RegistrationHeight: regInfo.RegistrationHeight,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRegisteredNodesResponse{
+		Nodes:      nodes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) VerifyAttestation(_ context.Context, req *types.QueryVerifyAttestationRequest) (*types.QueryVerifyAttestationResponse, error) {
+	if len(req.Certificate) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrCertificateInvalid, "empty certificate")
+	}
+
+	pubKey, failedCheck, err := q.keeper.VerifyAttestation(req.Certificate)
+	rsp := &types.QueryVerifyAttestationResponse{
+		Valid:       err == nil,
+		PubKey:      pubKey,
+		FailedCheck: failedCheck,
+	}
+	if err != nil {
+		rsp.FailureReason = err.Error()
+	}
+	return rsp, nil
+}
+
 func queryMasterKey(ctx sdk.Context, keeper Keeper) (*types.GenesisState, error) {
 	ioKey := keeper.GetMasterKey(ctx, types.MasterIoKeyId)
 	nodeKey := keeper.GetMasterKey(ctx, types.MasterNodeKeyId)
@@ -84,3 +146,21 @@ func queryEncryptedSeed(ctx sdk.Context, pubkeyBytes []byte, keeper Keeper) ([]b
 
 	return seed.EncryptedSeed, nil
 }
+
+// EnclaveHealth runs the enclave health check of this node. A failed check is reported in the
+// response rather than as an error, so operators always get the full status.
+func (q GrpcQuerier) EnclaveHealth(_ context.Context, _ *empty.Empty) (*types.QueryEnclaveHealthResponse, error) {
+	rsp := &types.QueryEnclaveHealthResponse{
+		Version:                version.Version,
+		RegistrationKeyPresent: hasRegistrationKey(),
+	}
+
+	status, err := q.keeper.enclave.HealthCheck()
+	if err != nil {
+		rsp.Error = err.Error()
+		return rsp, nil
+	}
+	rsp.Healthy = true
+	rsp.Status = string(status)
+	return rsp, nil
+}
@@ -3,12 +3,18 @@ package keeper
 //
 ////
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/keeper/mock"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 
@@ -102,3 +108,153 @@ func TestNewQuerier(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, string(binResult), string(expectedSecretParams))
 }
+
+func TestIoExchangePubKey(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err = NewQuerier(keeper).IoExchangePubKey(goCtx, nil)
+	require.True(t, types.ErrNotFound.Is(err), err)
+
+	ioKey := bytes.Repeat([]byte{0x1}, types.IoExchangePubKeyLength)
+	keeper.SetMasterKey(ctx, types.MasterKey{Bytes: ioKey}, types.MasterIoKeyId)
+
+	rsp, err := NewQuerier(keeper).IoExchangePubKey(goCtx, nil)
+	require.NoError(t, err)
+	require.Equal(t, ioKey, rsp.Key)
+	require.Equal(t, uint32(types.IoExchangePubKeyLength), rsp.KeyLength)
+	require.Len(t, rsp.Fingerprint, 64)
+
+	// the fingerprint changes with the key
+	keeper.SetMasterKey(ctx, types.MasterKey{Bytes: bytes.Repeat([]byte{0x2}, types.IoExchangePubKeyLength)}, types.MasterIoKeyId)
+	rotated, err := NewQuerier(keeper).IoExchangePubKey(goCtx, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, rsp.Fingerprint, rotated.Fingerprint)
+}
+
+func TestRegisteredNodes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	querier := NewQuerier(keeper)
+
+	rsp, err := querier.RegisteredNodes(sdk.WrapSDKContext(ctx), &types.QueryRegisteredNodesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, rsp.Nodes)
+
+	pubKeys := [][]byte{
+		bytes.Repeat([]byte{1}, 32),
+		bytes.Repeat([]byte{2}, 32),
+		bytes.Repeat([]byte{3}, 32),
+	}
+	for i, pubKey := range pubKeys {
+		keeper.SetRegistrationInfo_Verified(ctx, types.RegistrationNodeInfo{
+			EncryptedSeed:      []byte("seed"),
+			RegistrationHeight: int64(i + 1),
+		}, pubKey)
+	}
+
+	var nodes []types.RegisteredNode
+	var nextKey []byte
+	for {
+		rsp, err = querier.RegisteredNodes(sdk.WrapSDKContext(ctx), &types.QueryRegisteredNodesRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		nodes = append(nodes, rsp.Nodes...)
+
+		nextKey = rsp.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+
+	require.Len(t, nodes, len(pubKeys))
+	for i, node := range nodes {
+		require.Equal(t, pubKeys[i], node.PubKey)
+		require.Equal(t, int64(i+1), node.RegistrationHeight)
+	}
+}
+
+type seedCountingEnclave struct {
+	mock.MockEnclaveApi
+	seedRequests *int
+}
+
+func (e seedCountingEnclave) GetEncryptedSeed(cert []byte) ([]byte, error) {
+	*e.seedRequests++
+	return e.MockEnclaveApi.GetEncryptedSeed(cert)
+}
+
+func TestVerifyAttestation(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	seedRequests := 0
+	keeper.enclave = seedCountingEnclave{seedRequests: &seedRequests}
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+
+	rsp, err := NewQuerier(keeper).VerifyAttestation(goCtx, &types.QueryVerifyAttestationRequest{Certificate: cert})
+	require.NoError(t, err)
+	require.True(t, rsp.Valid)
+	require.NotEmpty(t, rsp.PubKey)
+	require.Equal(t, types.AttestationCheckNone, rsp.FailedCheck)
+	require.Empty(t, rsp.FailureReason)
+
+	rsp, err = NewQuerier(keeper).VerifyAttestation(goCtx, &types.QueryVerifyAttestationRequest{Certificate: []byte("not a certificate")})
+	require.NoError(t, err)
+	require.False(t, rsp.Valid)
+	require.Equal(t, types.AttestationCheckCertificate, rsp.FailedCheck)
+	require.NotEmpty(t, rsp.FailureReason)
+
+	// the query never asks the enclave for the seed, and no registration happened
+	require.Zero(t, seedRequests)
+	pubKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+	require.Nil(t, keeper.getRegistrationInfo(ctx, pubKey))
+
+	_, err = NewQuerier(keeper).VerifyAttestation(goCtx, &types.QueryVerifyAttestationRequest{})
+	require.True(t, types.ErrCertificateInvalid.Is(err), err)
+}
+
+type unhealthyEnclave struct {
+	mock.MockEnclaveApi
+}
+
+func (unhealthyEnclave) HealthCheck() ([]byte, error) {
+	return nil, errors.New("SGX_ERROR_ENCLAVE_LOST")
+}
+
+func TestEnclaveHealth(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	t.Setenv("SCRT_SGX_STORAGE", tempDir)
+
+	rsp, err := NewQuerier(keeper).EnclaveHealth(goCtx, nil)
+	require.NoError(t, err)
+	require.True(t, rsp.Healthy)
+	require.Empty(t, rsp.Error)
+	require.False(t, rsp.RegistrationKeyPresent)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, types.EnclaveRegistrationKey), []byte("sealed"), 0o600))
+
+	keeper.enclave = unhealthyEnclave{}
+	rsp, err = NewQuerier(keeper).EnclaveHealth(goCtx, nil)
+	require.NoError(t, err)
+	require.False(t, rsp.Healthy)
+	require.Empty(t, rsp.Status)
+	require.Equal(t, "SGX_ERROR_ENCLAVE_LOST", rsp.Error)
+	require.True(t, rsp.RegistrationKeyPresent)
+}
@@ -7,9 +7,11 @@ import (
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -31,6 +33,76 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AttestationCheck is a stage of the node registration verification
+type AttestationCheck int32
+
+const (
+	// AttestationCheckNone is set when every check passed
+	AttestationCheckNone AttestationCheck = 0
+	// AttestationCheckCertificate parses the certificate and verifies the report it carries
+	AttestationCheckCertificate AttestationCheck = 1
+)
+
+var AttestationCheck_name = map[int32]string{
+	0: "ATTESTATION_CHECK_NONE",
+	1: "ATTESTATION_CHECK_CERTIFICATE",
+}
+
+var AttestationCheck_value = map[string]int32{
+	"ATTESTATION_CHECK_NONE":        0,
+	"ATTESTATION_CHECK_CERTIFICATE": 1,
+}
+
+func (x AttestationCheck) String() string {
+	return proto.EnumName(AttestationCheck_name, int32(x))
+}
+
+func (AttestationCheck) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{0}
+}
+
+type QueryIoExchangePubKeyResponse struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// fingerprint is the hex encoded sha256 hash of the key. It only changes when the key does, so
+	// clients can compare it with the one they cached the key with to detect a stale key
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// key_length is the expected length of the key in bytes
+	KeyLength uint32 `protobuf:"varint,3,opt,name=key_length,json=keyLength,proto3" json:"key_length,omitempty"`
+}
+
+func (m *QueryIoExchangePubKeyResponse) Reset()         { *m = QueryIoExchangePubKeyResponse{} }
+func (m *QueryIoExchangePubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIoExchangePubKeyResponse) ProtoMessage()    {}
+func (*QueryIoExchangePubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{0}
+}
+func (m *QueryIoExchangePubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIoExchangePubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIoExchangePubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIoExchangePubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIoExchangePubKeyResponse.Merge(m, src)
+}
+func (m *QueryIoExchangePubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIoExchangePubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIoExchangePubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIoExchangePubKeyResponse proto.InternalMessageInfo
+
 type QueryEncryptedSeedRequest struct {
 	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 }
@@ -39,7 +111,7 @@ func (m *QueryEncryptedSeedRequest) Reset()         { *m = QueryEncryptedSeedReq
 func (m *QueryEncryptedSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEncryptedSeedRequest) ProtoMessage()    {}
 func (*QueryEncryptedSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ee71413f073b37c, []int{0}
+	return fileDescriptor_7ee71413f073b37c, []int{1}
 }
 func (m *QueryEncryptedSeedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +148,7 @@ func (m *QueryEncryptedSeedResponse) Reset()         { *m = QueryEncryptedSeedRe
 func (m *QueryEncryptedSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEncryptedSeedResponse) ProtoMessage()    {}
 func (*QueryEncryptedSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ee71413f073b37c, []int{1}
+	return fileDescriptor_7ee71413f073b37c, []int{2}
 }
 func (m *QueryEncryptedSeedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,9 +177,259 @@ func (m *QueryEncryptedSeedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEncryptedSeedResponse proto.InternalMessageInfo
 
+type QueryRegisteredNodesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredNodesRequest) Reset()         { *m = QueryRegisteredNodesRequest{} }
+func (m *QueryRegisteredNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodesRequest) ProtoMessage()    {}
+func (*QueryRegisteredNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{3}
+}
+func (m *QueryRegisteredNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodesRequest.Merge(m, src)
+}
+func (m *QueryRegisteredNodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredNodesRequest proto.InternalMessageInfo
+
+type RegisteredNode struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// registration_height is zero for nodes imported from genesis
+	RegistrationHeight int64 `protobuf:"varint,2,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+}
+
+func (m *RegisteredNode) Reset()         { *m = RegisteredNode{} }
+func (m *RegisteredNode) String() string { return proto.CompactTextString(m) }
+func (*RegisteredNode) ProtoMessage()    {}
+func (*RegisteredNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{4}
+}
+func (m *RegisteredNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredNode.Merge(m, src)
+}
+func (m *RegisteredNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredNode proto.InternalMessageInfo
+
+type QueryRegisteredNodesResponse struct {
+	Nodes []RegisteredNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredNodesResponse) Reset()         { *m = QueryRegisteredNodesResponse{} }
+func (m *QueryRegisteredNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodesResponse) ProtoMessage()    {}
+func (*QueryRegisteredNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{5}
+}
+func (m *QueryRegisteredNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodesResponse.Merge(m, src)
+}
+func (m *QueryRegisteredNodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRegisteredNodesResponse proto.InternalMessageInfo
+
+type QueryVerifyAttestationRequest struct {
+	Certificate github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,1,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"certificate,omitempty"`
+}
+
+func (m *QueryVerifyAttestationRequest) Reset()         { *m = QueryVerifyAttestationRequest{} }
+func (m *QueryVerifyAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyAttestationRequest) ProtoMessage()    {}
+func (*QueryVerifyAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{6}
+}
+func (m *QueryVerifyAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyAttestationRequest.Merge(m, src)
+}
+func (m *QueryVerifyAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyAttestationRequest proto.InternalMessageInfo
+
+type QueryVerifyAttestationResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// pub_key is the node public key found in the certificate, empty if it could not be extracted
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// failed_check is the first check that failed
+	FailedCheck AttestationCheck `protobuf:"varint,3,opt,name=failed_check,json=failedCheck,proto3,enum=secret.registration.v1beta1.AttestationCheck" json:"failed_check,omitempty"`
+	// failure_reason describes why the failed check rejected the certificate
+	FailureReason string `protobuf:"bytes,4,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+}
+
+func (m *QueryVerifyAttestationResponse) Reset()         { *m = QueryVerifyAttestationResponse{} }
+func (m *QueryVerifyAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyAttestationResponse) ProtoMessage()    {}
+func (*QueryVerifyAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{7}
+}
+func (m *QueryVerifyAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyAttestationResponse.Merge(m, src)
+}
+func (m *QueryVerifyAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyAttestationResponse proto.InternalMessageInfo
+
+type QueryEnclaveHealthResponse struct {
+	// healthy is set when the enclave answered the health check
+	Healthy bool `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// status is the health check result reported by the enclave
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// error describes why the health check failed, empty if it succeeded
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// version is the version of the node binary the enclave is shipped with
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// registration_key_present is set when the enclave sealed the key pair it registers the node with
+	RegistrationKeyPresent bool `protobuf:"varint,5,opt,name=registration_key_present,json=registrationKeyPresent,proto3" json:"registration_key_present,omitempty"`
+}
+
+func (m *QueryEnclaveHealthResponse) Reset()         { *m = QueryEnclaveHealthResponse{} }
+func (m *QueryEnclaveHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEnclaveHealthResponse) ProtoMessage()    {}
+func (*QueryEnclaveHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{8}
+}
+func (m *QueryEnclaveHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnclaveHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnclaveHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEnclaveHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnclaveHealthResponse.Merge(m, src)
+}
+func (m *QueryEnclaveHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnclaveHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnclaveHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEnclaveHealthResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("secret.registration.v1beta1.AttestationCheck", AttestationCheck_name, AttestationCheck_value)
+	proto.RegisterType((*QueryIoExchangePubKeyResponse)(nil), "secret.registration.v1beta1.QueryIoExchangePubKeyResponse")
 	proto.RegisterType((*QueryEncryptedSeedRequest)(nil), "secret.registration.v1beta1.QueryEncryptedSeedRequest")
 	proto.RegisterType((*QueryEncryptedSeedResponse)(nil), "secret.registration.v1beta1.QueryEncryptedSeedResponse")
+	proto.RegisterType((*QueryRegisteredNodesRequest)(nil), "secret.registration.v1beta1.QueryRegisteredNodesRequest")
+	proto.RegisterType((*RegisteredNode)(nil), "secret.registration.v1beta1.RegisteredNode")
+	proto.RegisterType((*QueryRegisteredNodesResponse)(nil), "secret.registration.v1beta1.QueryRegisteredNodesResponse")
+	proto.RegisterType((*QueryVerifyAttestationRequest)(nil), "secret.registration.v1beta1.QueryVerifyAttestationRequest")
+	proto.RegisterType((*QueryVerifyAttestationResponse)(nil), "secret.registration.v1beta1.QueryVerifyAttestationResponse")
+	proto.RegisterType((*QueryEnclaveHealthResponse)(nil), "secret.registration.v1beta1.QueryEnclaveHealthResponse")
 }
 
 func init() {
@@ -115,36 +437,107 @@ func init() {
 }
 
 var fileDescriptor_7ee71413f073b37c = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0xcd, 0x8a, 0xd4, 0x40,
-	0x18, 0x4c, 0x94, 0x5d, 0xa1, 0x71, 0x15, 0x1a, 0xf1, 0x27, 0xbb, 0x34, 0xcb, 0xe0, 0xea, 0x7a,
-	0xd8, 0x6e, 0x57, 0x65, 0x3d, 0x0a, 0xca, 0x9e, 0x16, 0x04, 0x67, 0x3d, 0x79, 0x59, 0x92, 0xcc,
-	0x67, 0x1b, 0x66, 0xa6, 0xbb, 0xa7, 0xbb, 0xa3, 0x13, 0x06, 0x2f, 0x3e, 0x81, 0xe0, 0x4b, 0xcc,
-	0x23, 0xf8, 0x08, 0x73, 0x1c, 0xf0, 0xe2, 0x51, 0x33, 0x3e, 0x88, 0xa4, 0xd3, 0x23, 0x19, 0x88,
-	0x01, 0xf1, 0x96, 0xf4, 0x57, 0x5f, 0x55, 0x7d, 0x55, 0xe8, 0xbe, 0x81, 0x54, 0x83, 0x65, 0x1a,
-	0x78, 0x66, 0xac, 0x8e, 0x6d, 0x26, 0x05, 0x7b, 0x7f, 0x9c, 0x80, 0x8d, 0x8f, 0xd9, 0x24, 0x07,
-	0x5d, 0x50, 0xa5, 0xa5, 0x95, 0x78, 0xb7, 0x06, 0xd2, 0x26, 0x90, 0x7a, 0x60, 0x74, 0x83, 0x4b,
-	0x2e, 0x1d, 0x8e, 0x55, 0x5f, 0xf5, 0x4a, 0xb4, 0xcb, 0xa5, 0xe4, 0x23, 0x60, 0xee, 0x2f, 0xc9,
-	0xdf, 0x32, 0x18, 0x2b, 0xeb, 0xf9, 0xa2, 0x3d, 0x3f, 0x8c, 0x55, 0xc6, 0x62, 0x21, 0xa4, 0x75,
-	0x8c, 0xc6, 0x4f, 0x0f, 0xba, 0x6c, 0x8d, 0x0d, 0xf7, 0xb0, 0x07, 0x5d, 0x30, 0x0e, 0x02, 0x4c,
-	0xe6, 0x19, 0x7b, 0x4f, 0xd0, 0x9d, 0x57, 0xd5, 0x39, 0xa7, 0x22, 0xd5, 0x85, 0xb2, 0x30, 0x38,
-	0x07, 0x18, 0xf4, 0x61, 0x92, 0x83, 0xb1, 0xf8, 0x16, 0xba, 0xa2, 0xf2, 0xe4, 0x62, 0x08, 0xc5,
-	0xed, 0x70, 0x3f, 0x3c, 0xbc, 0xda, 0xdf, 0x56, 0x79, 0x72, 0x06, 0x45, 0xef, 0x05, 0x8a, 0xda,
-	0xb6, 0x8c, 0x92, 0xc2, 0x00, 0x3e, 0x40, 0xd7, 0x60, 0x3d, 0xb8, 0x30, 0x00, 0x03, 0xbf, 0xbd,
-	0x03, 0x4d, 0xf8, 0xa3, 0xf9, 0x65, 0xb4, 0xe5, 0x58, 0x30, 0x47, 0x5b, 0xaf, 0xa7, 0x67, 0x50,
-	0xe0, 0x9b, 0xb4, 0x3e, 0x9f, 0xae, 0xb3, 0xa1, 0xa7, 0x55, 0x36, 0xd1, 0x3e, 0xed, 0x88, 0x99,
-	0x56, 0x8e, 0xee, 0x7e, 0xfa, 0xf6, 0xeb, 0xcb, 0x25, 0x82, 0xf7, 0xda, 0x8f, 0xb6, 0xd3, 0xa3,
-	0x21, 0x14, 0x78, 0x86, 0xae, 0xf7, 0x1b, 0xe3, 0xff, 0x93, 0xa4, 0x4e, 0xf2, 0x10, 0xdf, 0x6b,
-	0x97, 0x6c, 0x3e, 0x3a, 0xf1, 0xaf, 0x21, 0xda, 0xd9, 0x08, 0x0c, 0x9f, 0x74, 0x6a, 0xfc, 0xb5,
-	0x97, 0xe8, 0xe9, 0x3f, 0xef, 0xd5, 0xcd, 0xf4, 0x4e, 0x9c, 0xe5, 0x87, 0x98, 0xb6, 0x5b, 0xfe,
-	0xd3, 0xcf, 0x51, 0xd5, 0x1a, 0x9b, 0xf9, 0xf2, 0x3f, 0x3e, 0x8f, 0x17, 0x3f, 0x49, 0x30, 0x2f,
-	0x49, 0xb8, 0x28, 0x49, 0xb8, 0x2c, 0x49, 0xf8, 0xa3, 0x24, 0xe1, 0xe7, 0x15, 0x09, 0x96, 0x2b,
-	0x12, 0x7c, 0x5f, 0x91, 0xe0, 0xcd, 0x33, 0x9e, 0xd9, 0x77, 0x79, 0x42, 0x53, 0x39, 0x66, 0x26,
-	0xd5, 0x76, 0x14, 0x27, 0x86, 0x9d, 0x3b, 0x97, 0x2f, 0xc1, 0x7e, 0x90, 0x7a, 0xc8, 0xa6, 0x9b,
-	0xa2, 0x99, 0xb0, 0xa0, 0x45, 0x3c, 0x62, 0xb6, 0x50, 0x60, 0x92, 0x6d, 0x97, 0xff, 0xe3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x62, 0xa4, 0x1c, 0x7a, 0x03, 0x00, 0x00,
+	// 1085 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xe6, 0x57, 0x9b, 0x49, 0x13, 0xc2, 0x10, 0x05, 0xb3, 0x49, 0x36, 0xd6, 0xaa, 0x4d,
+	0xdd, 0x14, 0xef, 0x92, 0xb4, 0x2a, 0x25, 0x1c, 0x50, 0x62, 0xb9, 0x4d, 0x14, 0xe4, 0x84, 0x8d,
+	0xc5, 0xa1, 0x17, 0x6b, 0x6d, 0xbf, 0xac, 0x57, 0x76, 0x76, 0xb6, 0x33, 0xe3, 0x10, 0xab, 0xe2,
+	0xc2, 0x09, 0xf5, 0x80, 0x10, 0x48, 0x48, 0x1c, 0x2a, 0x21, 0x71, 0xe1, 0x2f, 0xa8, 0x7a, 0xe5,
+	0x96, 0x63, 0x05, 0x17, 0x4e, 0x15, 0x24, 0x1c, 0xf8, 0x1b, 0x38, 0xa1, 0x9d, 0x19, 0xdb, 0xeb,
+	0xc4, 0x76, 0xd2, 0x72, 0xdb, 0x99, 0x79, 0xdf, 0xfb, 0xbe, 0xf7, 0x63, 0xdf, 0x43, 0x37, 0x19,
+	0x94, 0x29, 0x70, 0x9b, 0x82, 0xe7, 0x33, 0x4e, 0x5d, 0xee, 0x93, 0xc0, 0x3e, 0x5c, 0x29, 0x01,
+	0x77, 0x57, 0xec, 0xc7, 0x0d, 0xa0, 0x4d, 0x2b, 0xa4, 0x84, 0x13, 0x3c, 0x27, 0x0d, 0xad, 0xb8,
+	0xa1, 0xa5, 0x0c, 0xf5, 0x19, 0x8f, 0x78, 0x44, 0xd8, 0xd9, 0xd1, 0x97, 0x84, 0xe8, 0x73, 0x1e,
+	0x21, 0x5e, 0x1d, 0x6c, 0x71, 0x2a, 0x35, 0xf6, 0x6d, 0x38, 0x08, 0xb9, 0xf2, 0xa7, 0xcf, 0xab,
+	0x47, 0x37, 0xf4, 0x6d, 0x37, 0x08, 0x08, 0x17, 0x1e, 0x99, 0x7a, 0x5d, 0x2e, 0x13, 0x76, 0x40,
+	0x98, 0x5d, 0x72, 0x19, 0x48, 0x19, 0x6d, 0x51, 0xa1, 0xeb, 0xf9, 0x81, 0xa4, 0x97, 0xb6, 0x37,
+	0x06, 0x85, 0x70, 0xc0, 0x3c, 0x65, 0x76, 0x6b, 0x90, 0x99, 0x07, 0x01, 0x30, 0x5f, 0xb1, 0x9b,
+	0x14, 0x2d, 0x7c, 0x16, 0x71, 0x6e, 0x91, 0xdc, 0x51, 0xb9, 0xea, 0x06, 0x1e, 0xec, 0x36, 0x4a,
+	0xdb, 0xd0, 0x74, 0x80, 0x85, 0x24, 0x60, 0x80, 0xa7, 0xd1, 0x70, 0x0d, 0x9a, 0x49, 0x2d, 0xa5,
+	0xa5, 0xaf, 0x39, 0xd1, 0x27, 0x4e, 0xa1, 0x89, 0x7d, 0x3f, 0xf0, 0x80, 0x86, 0xd4, 0x0f, 0x78,
+	0x72, 0x28, 0xa5, 0xa5, 0xc7, 0x9d, 0xf8, 0x15, 0x5e, 0x40, 0xa8, 0x06, 0xcd, 0x62, 0x1d, 0x02,
+	0x8f, 0x57, 0x93, 0xc3, 0x29, 0x2d, 0x3d, 0xe9, 0x8c, 0xd7, 0xa0, 0xf9, 0xa9, 0xb8, 0x30, 0xef,
+	0xa2, 0xf7, 0x04, 0x67, 0x2e, 0x28, 0xd3, 0x66, 0xc8, 0xa1, 0xb2, 0x07, 0x50, 0x71, 0xe0, 0x71,
+	0x03, 0x18, 0xc7, 0xef, 0xa2, 0x2b, 0x61, 0xa3, 0x54, 0xec, 0x70, 0x8e, 0x85, 0x42, 0x90, 0x99,
+	0x45, 0x7a, 0x2f, 0x94, 0x92, 0x79, 0x03, 0x4d, 0x41, 0xeb, 0xa1, 0xc8, 0x00, 0x2a, 0x0a, 0x3d,
+	0x09, 0x71, 0x73, 0xb3, 0x86, 0xe6, 0x84, 0x13, 0x47, 0x64, 0x06, 0x28, 0x54, 0xf2, 0xa4, 0x02,
+	0xac, 0x45, 0xfe, 0x00, 0xa1, 0x4e, 0xce, 0x85, 0x87, 0x89, 0xd5, 0x25, 0x4b, 0x16, 0xc8, 0x8a,
+	0x0a, 0x64, 0xc9, 0x3e, 0x51, 0xb9, 0xb4, 0x76, 0x5d, 0x0f, 0x14, 0xd6, 0x89, 0x21, 0xd7, 0x46,
+	0xfe, 0xf9, 0x69, 0x31, 0x61, 0x3e, 0x42, 0x53, 0xdd, 0x3c, 0x7d, 0x83, 0xc3, 0x36, 0x7a, 0x27,
+	0x5e, 0xac, 0x62, 0x15, 0x7c, 0xaf, 0x2a, 0x73, 0x3b, 0xec, 0xe0, 0xf8, 0xd3, 0xa6, 0x78, 0x31,
+	0x9f, 0x6b, 0x68, 0xbe, 0x77, 0x24, 0x2a, 0x21, 0x0f, 0xd1, 0x68, 0x10, 0x5d, 0x24, 0xb5, 0xd4,
+	0x70, 0x7a, 0x62, 0xf5, 0xb6, 0x35, 0xa0, 0xa9, 0xad, 0x6e, 0x27, 0x1b, 0x23, 0xc7, 0xaf, 0x16,
+	0x13, 0x8e, 0xc4, 0xe3, 0x87, 0x5d, 0x39, 0x19, 0x12, 0x39, 0xb9, 0x79, 0x61, 0x4e, 0xa4, 0x8a,
+	0x1e, 0x49, 0xf9, 0x41, 0x53, 0x1d, 0xf7, 0x39, 0x50, 0x7f, 0xbf, 0xb9, 0xce, 0x39, 0x30, 0xf9,
+	0x43, 0xb4, 0x8a, 0xd0, 0x40, 0x13, 0x65, 0xa0, 0xdc, 0xdf, 0xf7, 0xcb, 0x2e, 0x07, 0x99, 0xa8,
+	0x8d, 0xbd, 0x7f, 0x5f, 0x2d, 0xee, 0x78, 0x3e, 0xaf, 0x36, 0x4a, 0x56, 0x99, 0x1c, 0xd8, 0xac,
+	0x4c, 0x79, 0xdd, 0x2d, 0x31, 0x7b, 0x4f, 0x84, 0x95, 0x07, 0xfe, 0x05, 0xa1, 0x35, 0xfb, 0xa8,
+	0xbb, 0xe7, 0x29, 0x1c, 0x10, 0x0e, 0x45, 0xb7, 0x43, 0x62, 0x65, 0x3b, 0xae, 0x9d, 0x38, 0x8f,
+	0x79, 0xac, 0x21, 0xa3, 0x9f, 0x30, 0x95, 0xd3, 0x19, 0x34, 0x7a, 0xe8, 0xd6, 0x7d, 0xd9, 0x5b,
+	0x57, 0x1d, 0x79, 0x88, 0x17, 0x75, 0xa8, 0xab, 0xa8, 0xbb, 0xe8, 0xda, 0xbe, 0xeb, 0xd7, 0xa1,
+	0x52, 0x2c, 0x57, 0xa1, 0x5c, 0x13, 0x3f, 0xc2, 0xd4, 0x6a, 0x66, 0x60, 0x25, 0x62, 0xb4, 0xd9,
+	0x08, 0xe4, 0x4c, 0x48, 0x17, 0xe2, 0x10, 0x75, 0x79, 0x74, 0x6c, 0x50, 0x28, 0x52, 0x70, 0x19,
+	0x09, 0x92, 0x23, 0xe2, 0xef, 0x9b, 0x54, 0xb7, 0x8e, 0xb8, 0x34, 0x5f, 0x68, 0x9d, 0x7f, 0xa5,
+	0xee, 0x1e, 0xc2, 0x26, 0xb8, 0x75, 0x5e, 0x6d, 0x87, 0x91, 0x44, 0x57, 0xaa, 0xe2, 0xa6, 0xa9,
+	0x02, 0x69, 0x1d, 0xf1, 0x2c, 0x1a, 0x8b, 0xc8, 0x1b, 0x4c, 0xfd, 0xd5, 0xea, 0x14, 0x05, 0x0e,
+	0x94, 0x12, 0x2a, 0x42, 0x18, 0x77, 0xe4, 0x21, 0xf2, 0x73, 0x08, 0x94, 0xf9, 0x6d, 0x19, 0xad,
+	0x23, 0xbe, 0x8f, 0x92, 0x5d, 0xed, 0x1c, 0x4d, 0x83, 0x90, 0x02, 0x83, 0x80, 0x27, 0x47, 0x05,
+	0xe5, 0x6c, 0xfc, 0x7d, 0x1b, 0x9a, 0xbb, 0xf2, 0x75, 0xf9, 0x3b, 0x0d, 0x4d, 0x9f, 0xcd, 0x01,
+	0xbe, 0x8b, 0x66, 0xd7, 0x0b, 0x85, 0xdc, 0x5e, 0x61, 0xbd, 0xb0, 0xb5, 0x93, 0x2f, 0x66, 0x37,
+	0x73, 0xd9, 0xed, 0x62, 0x7e, 0x27, 0x9f, 0x9b, 0x4e, 0xe8, 0xc9, 0xa7, 0xcf, 0x52, 0x33, 0x67,
+	0x11, 0x79, 0x12, 0x00, 0xde, 0x40, 0x0b, 0xe7, 0x51, 0xd9, 0x9c, 0x53, 0xd8, 0x7a, 0xb0, 0x95,
+	0x5d, 0x2f, 0xe4, 0xa6, 0x35, 0x7d, 0xf1, 0xe9, 0xb3, 0xd4, 0xdc, 0x59, 0x70, 0xac, 0x43, 0xf4,
+	0x91, 0xaf, 0x7f, 0x36, 0x12, 0xab, 0xbf, 0x5d, 0x45, 0xa3, 0x22, 0x9f, 0xd8, 0x43, 0xa3, 0x85,
+	0xa3, 0xa8, 0xb6, 0xb3, 0x96, 0x1c, 0xea, 0x56, 0x6b, 0xe2, 0x5b, 0xb9, 0x68, 0xe2, 0xeb, 0xa9,
+	0x81, 0xd5, 0x8d, 0xe6, 0xd8, 0xf5, 0xaf, 0x7e, 0xff, 0xfb, 0xfb, 0x21, 0x03, 0xcf, 0xf7, 0x1e,
+	0xcf, 0xfc, 0x28, 0x13, 0x0d, 0xd9, 0x27, 0xe8, 0x2d, 0xa7, 0x3b, 0x43, 0xff, 0x83, 0xd2, 0x12,
+	0x94, 0x69, 0xbc, 0xd4, 0x9b, 0x32, 0x7e, 0x29, 0xc8, 0x7f, 0xd4, 0xd0, 0xf4, 0xd9, 0x85, 0xd0,
+	0x97, 0x7e, 0x6d, 0x20, 0xfd, 0xc0, 0xe5, 0x62, 0xae, 0x08, 0x61, 0xb7, 0xf1, 0xad, 0xde, 0xc2,
+	0x7c, 0x92, 0x01, 0x05, 0xcc, 0x84, 0x8d, 0x92, 0xd0, 0xf6, 0x42, 0x43, 0x93, 0x5d, 0x2b, 0x00,
+	0xdf, 0xbb, 0x58, 0x40, 0xaf, 0x4d, 0xa3, 0x7f, 0xf8, 0xda, 0x38, 0xa5, 0xfa, 0x9e, 0x50, 0xfd,
+	0x01, 0xb6, 0x7a, 0xab, 0x6e, 0x6f, 0x9c, 0x4c, 0xb4, 0x87, 0xec, 0x27, 0x6a, 0x38, 0x7c, 0x89,
+	0x9f, 0x6b, 0xad, 0xa2, 0xb6, 0xc7, 0x35, 0xbe, 0x7f, 0xb1, 0x88, 0xde, 0xbb, 0x4a, 0xff, 0xe8,
+	0x0d, 0x90, 0x2a, 0x80, 0x4b, 0xf5, 0x43, 0x04, 0xcb, 0xc8, 0x15, 0xf0, 0xab, 0x86, 0xde, 0x3e,
+	0x37, 0x15, 0xf1, 0x25, 0x0a, 0xdf, 0x6f, 0xc6, 0xeb, 0x1f, 0xbf, 0x11, 0x56, 0xc9, 0xbf, 0x23,
+	0xe4, 0x67, 0xcc, 0x74, 0x6f, 0xf9, 0x87, 0x02, 0x98, 0x89, 0x0d, 0xfd, 0x35, 0x6d, 0x19, 0x7f,
+	0x23, 0xfb, 0xa6, 0x33, 0x0e, 0xfb, 0x36, 0xf4, 0xe5, 0xfa, 0xe2, 0xfc, 0x5c, 0x35, 0xdf, 0x17,
+	0xba, 0x96, 0xf0, 0xf5, 0xbe, 0x7d, 0x11, 0x81, 0x32, 0x72, 0xd8, 0x6e, 0xb8, 0xc7, 0x7f, 0x19,
+	0x89, 0x5f, 0x4e, 0x0c, 0xed, 0xf8, 0xc4, 0xd0, 0x5e, 0x9e, 0x18, 0xda, 0x9f, 0x27, 0x86, 0xf6,
+	0xed, 0xa9, 0x91, 0x78, 0x79, 0x6a, 0x24, 0xfe, 0x38, 0x35, 0x12, 0x8f, 0x3e, 0x79, 0xed, 0x7d,
+	0xe7, 0x07, 0x1c, 0x68, 0xe0, 0xd6, 0x6d, 0xde, 0x0c, 0x81, 0x95, 0xc6, 0x44, 0x64, 0x77, 0xfe,
+	0x0b, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xd0, 0xe1, 0x0c, 0xfa, 0x0a, 0x00, 0x00,
 }
 
+func (this *QueryIoExchangePubKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryIoExchangePubKeyResponse)
+	if !ok {
+		that2, ok := that.(QueryIoExchangePubKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if this.Fingerprint != that1.Fingerprint {
+		return false
+	}
+	if this.KeyLength != that1.KeyLength {
+		return false
+	}
+	return true
+}
 func (this *QueryEncryptedSeedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -193,60 +586,226 @@ func (this *QueryEncryptedSeedResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Returns the key used for transactions
-	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the key used for registration
-	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the encrypted seed for a registered node by public key
-	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
-
-func (c *queryClient) TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
-	out := new(Key)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/TxKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *RegisteredNode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *queryClient) RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
-	out := new(Key)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegistrationKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*RegisteredNode)
+	if !ok {
+		that2, ok := that.(RegisteredNode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *queryClient) EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error) {
-	out := new(QueryEncryptedSeedResponse)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EncryptedSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	return true
+}
+func (this *QueryVerifyAttestationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryVerifyAttestationRequest)
+	if !ok {
+		that2, ok := that.(QueryVerifyAttestationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Certificate, that1.Certificate) {
+		return false
+	}
+	return true
+}
+func (this *QueryVerifyAttestationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryVerifyAttestationResponse)
+	if !ok {
+		that2, ok := that.(QueryVerifyAttestationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Valid != that1.Valid {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.FailedCheck != that1.FailedCheck {
+		return false
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	return true
+}
+func (this *QueryEnclaveHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryEnclaveHealthResponse)
+	if !ok {
+		that2, ok := that.(QueryEnclaveHealthResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Healthy != that1.Healthy {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.RegistrationKeyPresent != that1.RegistrationKeyPresent {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Returns the key used for transactions
+	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the key used for registration
+	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the consensus IO exchange public key used to encrypt contract messages
+	IoExchangePubKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryIoExchangePubKeyResponse, error)
+	// Returns the encrypted seed for a registered node by public key
+	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
+	// Returns the public keys and registration heights of all registered nodes
+	RegisteredNodes(ctx context.Context, in *QueryRegisteredNodesRequest, opts ...grpc.CallOption) (*QueryRegisteredNodesResponse, error)
+	// Checks the attestation report of a certificate, without registering the node. The MRSIGNER and TCB
+	// level checks run inside the enclave when the node registers, so they aren't part of this query.
+	VerifyAttestation(ctx context.Context, in *QueryVerifyAttestationRequest, opts ...grpc.CallOption) (*QueryVerifyAttestationResponse, error)
+	// Runs a round-trip to the enclave of the queried node and reports its status. This is a
+	// diagnostic of the node answering the query, not of consensus state
+	EnclaveHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryEnclaveHealthResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
+	out := new(Key)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/TxKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
+	out := new(Key)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegistrationKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IoExchangePubKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryIoExchangePubKeyResponse, error) {
+	out := new(QueryIoExchangePubKeyResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/IoExchangePubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error) {
+	out := new(QueryEncryptedSeedResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EncryptedSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredNodes(ctx context.Context, in *QueryRegisteredNodesRequest, opts ...grpc.CallOption) (*QueryRegisteredNodesResponse, error) {
+	out := new(QueryRegisteredNodesResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegisteredNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyAttestation(ctx context.Context, in *QueryVerifyAttestationRequest, opts ...grpc.CallOption) (*QueryVerifyAttestationResponse, error) {
+	out := new(QueryVerifyAttestationResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/VerifyAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EnclaveHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryEnclaveHealthResponse, error) {
+	out := new(QueryEnclaveHealthResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EnclaveHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 // QueryServer is the server API for Query service.
@@ -255,8 +814,18 @@ type QueryServer interface {
 	TxKey(context.Context, *emptypb.Empty) (*Key, error)
 	// Returns the key used for registration
 	RegistrationKey(context.Context, *emptypb.Empty) (*Key, error)
+	// Returns the consensus IO exchange public key used to encrypt contract messages
+	IoExchangePubKey(context.Context, *emptypb.Empty) (*QueryIoExchangePubKeyResponse, error)
 	// Returns the encrypted seed for a registered node by public key
 	EncryptedSeed(context.Context, *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error)
+	// Returns the public keys and registration heights of all registered nodes
+	RegisteredNodes(context.Context, *QueryRegisteredNodesRequest) (*QueryRegisteredNodesResponse, error)
+	// Checks the attestation report of a certificate, without registering the node. The MRSIGNER and TCB
+	// level checks run inside the enclave when the node registers, so they aren't part of this query.
+	VerifyAttestation(context.Context, *QueryVerifyAttestationRequest) (*QueryVerifyAttestationResponse, error)
+	// Runs a round-trip to the enclave of the queried node and reports its status. This is a
+	// diagnostic of the node answering the query, not of consensus state
+	EnclaveHealth(context.Context, *emptypb.Empty) (*QueryEnclaveHealthResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -269,9 +838,21 @@ func (*UnimplementedQueryServer) TxKey(ctx context.Context, req *emptypb.Empty)
 func (*UnimplementedQueryServer) RegistrationKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegistrationKey not implemented")
 }
+func (*UnimplementedQueryServer) IoExchangePubKey(ctx context.Context, req *emptypb.Empty) (*QueryIoExchangePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IoExchangePubKey not implemented")
+}
 func (*UnimplementedQueryServer) EncryptedSeed(ctx context.Context, req *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EncryptedSeed not implemented")
 }
+func (*UnimplementedQueryServer) RegisteredNodes(ctx context.Context, req *QueryRegisteredNodesRequest) (*QueryRegisteredNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredNodes not implemented")
+}
+func (*UnimplementedQueryServer) VerifyAttestation(ctx context.Context, req *QueryVerifyAttestationRequest) (*QueryVerifyAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAttestation not implemented")
+}
+func (*UnimplementedQueryServer) EnclaveHealth(ctx context.Context, req *emptypb.Empty) (*QueryEnclaveHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnclaveHealth not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -313,6 +894,24 @@ func _Query_RegistrationKey_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_IoExchangePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IoExchangePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/IoExchangePubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IoExchangePubKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EncryptedSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEncryptedSeedRequest)
 	if err := dec(in); err != nil {
@@ -331,6 +930,60 @@ func _Query_EncryptedSeed_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RegisteredNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/RegisteredNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredNodes(ctx, req.(*QueryRegisteredNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/VerifyAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyAttestation(ctx, req.(*QueryVerifyAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EnclaveHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EnclaveHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/EnclaveHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EnclaveHealth(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "secret.registration.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -343,15 +996,73 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegistrationKey",
 			Handler:    _Query_RegistrationKey_Handler,
 		},
+		{
+			MethodName: "IoExchangePubKey",
+			Handler:    _Query_IoExchangePubKey_Handler,
+		},
 		{
 			MethodName: "EncryptedSeed",
 			Handler:    _Query_EncryptedSeed_Handler,
 		},
+		{
+			MethodName: "RegisteredNodes",
+			Handler:    _Query_RegisteredNodes_Handler,
+		},
+		{
+			MethodName: "VerifyAttestation",
+			Handler:    _Query_VerifyAttestation_Handler,
+		},
+		{
+			MethodName: "EnclaveHealth",
+			Handler:    _Query_EnclaveHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret/registration/v1beta1/query.proto",
 }
 
+func (m *QueryIoExchangePubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIoExchangePubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIoExchangePubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryEncryptedSeedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -412,17 +1123,302 @@ func (m *QueryEncryptedSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *QueryRegisteredNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FailedCheck != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedCheck))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEnclaveHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEnclaveHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEnclaveHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationKeyPresent {
+		i--
+		if m.RegistrationKeyPresent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *QueryIoExchangePubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyLength != 0 {
+		n += 1 + sovQuery(uint64(m.KeyLength))
+	}
+	return n
+}
+
 func (m *QueryEncryptedSeedRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -433,29 +1429,836 @@ func (m *QueryEncryptedSeedRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryEncryptedSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncryptedSeed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RegistrationHeight))
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FailedCheck != 0 {
+		n += 1 + sovQuery(uint64(m.FailedCheck))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEnclaveHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Healthy {
+		n += 2
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegistrationKeyPresent {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIoExchangePubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIoExchangePubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIoExchangePubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyLength", wireType)
+			}
+			m.KeyLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSeed == nil {
+				m.EncryptedSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, RegisteredNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryEncryptedSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EncryptedSeed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryVerifyAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyAttestationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -478,13 +2281,33 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyAttestationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
@@ -518,6 +2341,57 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCheck", wireType)
+			}
+			m.FailedCheck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedCheck |= AttestationCheck(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -539,7 +2413,7 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEnclaveHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -562,17 +2436,37 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEnclaveHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEnclaveHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -582,26 +2476,108 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedSeed == nil {
-				m.EncryptedSeed = []byte{}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationKeyPresent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegistrationKeyPresent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
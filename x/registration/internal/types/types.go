@@ -8,6 +8,7 @@ import (
 const (
 	EnclaveRegistrationKey     = "new_node_seed_exchange_keypair.sealed"
 	PublicKeyLength            = 64  // encoded length
+	IoExchangePubKeyLength     = 32  // x25519 public key length
 	EncryptedKeyLength         = 192 // hex encoded length
 	LegacyEncryptedKeyLength   = 96  // hex encoded length
 	MasterNodeKeyId            = "NodeExchMasterKey"
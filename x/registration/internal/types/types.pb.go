@@ -105,6 +105,8 @@ var xxx_messageInfo_LegacySeedConfig proto.InternalMessageInfo
 type RegistrationNodeInfo struct {
 	Certificate   github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,1,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"certificate,omitempty"`
 	EncryptedSeed []byte                                                                          `protobuf:"bytes,2,opt,name=encrypted_seed,json=encryptedSeed,proto3" json:"encrypted_seed,omitempty"`
+	// registration_height is the block height the node registered at, zero for nodes imported from genesis
+	RegistrationHeight int64 `protobuf:"varint,3,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
 }
 
 func (m *RegistrationNodeInfo) Reset()         { *m = RegistrationNodeInfo{} }
@@ -151,32 +153,33 @@ func init() {
 }
 
 var fileDescriptor_f3db05f1d182f4de = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6e, 0xd4, 0x40,
-	0x14, 0x80, 0x3d, 0x89, 0xb4, 0x51, 0x66, 0x37, 0x08, 0x59, 0x29, 0x22, 0x90, 0xc6, 0xd1, 0x4a,
-	0x21, 0xa9, 0x3c, 0x0a, 0x1c, 0x00, 0xc9, 0x5b, 0xa1, 0xa0, 0x20, 0x79, 0x3b, 0x9a, 0x68, 0x3c,
-	0x7e, 0x6b, 0x46, 0xde, 0x9d, 0xb1, 0x66, 0x5e, 0x02, 0xbe, 0x03, 0x05, 0xc7, 0xe0, 0x00, 0x1c,
-	0x22, 0x65, 0x4a, 0x2a, 0x0b, 0xbc, 0x9d, 0x8f, 0x40, 0x85, 0xfc, 0x13, 0xd6, 0x94, 0xe9, 0x9e,
-	0xec, 0xef, 0xcd, 0xfb, 0xde, 0x0f, 0x3d, 0x77, 0x20, 0x2d, 0x20, 0xb7, 0x90, 0x29, 0x87, 0x56,
-	0xa0, 0x32, 0x9a, 0xdf, 0x5d, 0x26, 0x80, 0xe2, 0x92, 0x63, 0x59, 0x80, 0x0b, 0x0b, 0x6b, 0xd0,
-	0xf8, 0x2f, 0x7b, 0x30, 0x1c, 0x83, 0xe1, 0x00, 0xbe, 0x38, 0xce, 0x4c, 0x66, 0x3a, 0x8e, 0xb7,
-	0x51, 0x9f, 0x32, 0xff, 0x4a, 0x28, 0x5d, 0x02, 0xa4, 0x0b, 0xa3, 0x57, 0x2a, 0xf3, 0x5f, 0x51,
-	0xba, 0x11, 0x0e, 0xc1, 0xde, 0xe4, 0x50, 0x9e, 0x90, 0x53, 0x72, 0x71, 0x18, 0x1d, 0x34, 0x55,
-	0xb0, 0x5f, 0xe4, 0xaf, 0xe3, 0xc3, 0xfe, 0xd7, 0x15, 0x94, 0x3e, 0xa7, 0x47, 0xa0, 0xa5, 0x2d,
-	0x0b, 0x84, 0xb4, 0x43, 0xf7, 0x3a, 0x94, 0x36, 0x55, 0x30, 0x01, 0x2d, 0xaf, 0xa0, 0x8c, 0x67,
-	0xff, 0x80, 0x36, 0xe1, 0x8c, 0x1e, 0xdc, 0x81, 0x75, 0xca, 0xe8, 0x93, 0xfd, 0x53, 0x72, 0x71,
-	0x14, 0x4d, 0x9b, 0x2a, 0x78, 0xfc, 0x14, 0x3f, 0x06, 0xf3, 0x35, 0x7d, 0xfe, 0x1e, 0x32, 0x21,
-	0xcb, 0x91, 0xd3, 0x39, 0x9d, 0x0e, 0x4e, 0x12, 0x2c, 0x0e, 0x52, 0x93, 0xa6, 0x0a, 0xf6, 0x8a,
-	0x3c, 0x1e, 0x74, 0x17, 0x60, 0xf1, 0xc9, 0x52, 0xf3, 0x1f, 0x84, 0x1e, 0xc7, 0xa3, 0x59, 0x5d,
-	0x9b, 0x14, 0xde, 0xe9, 0x95, 0xf1, 0x6f, 0xe9, 0xb4, 0xad, 0xa5, 0x56, 0x4a, 0x0a, 0x84, 0xae,
-	0xe4, 0x2c, 0x5a, 0xfe, 0xa9, 0x82, 0x0f, 0x99, 0xc2, 0x4f, 0xb7, 0x49, 0x28, 0xcd, 0x86, 0x3b,
-	0x69, 0x71, 0x2d, 0x12, 0xc7, 0x97, 0xdd, 0xd4, 0xaf, 0x01, 0x3f, 0x1b, 0x9b, 0xf3, 0x2f, 0xff,
-	0xef, 0xc9, 0xc2, 0xc6, 0x20, 0xdc, 0x08, 0x44, 0x70, 0xd8, 0x6f, 0x64, 0xb1, 0x7b, 0x3a, 0x1e,
-	0xd7, 0xf1, 0xcf, 0xe8, 0xb3, 0x5d, 0x03, 0x0e, 0x20, 0xed, 0x3a, 0x98, 0xc5, 0xbb, 0xb6, 0xda,
-	0xb1, 0x44, 0xe2, 0xfe, 0x37, 0xf3, 0xbe, 0xd7, 0x8c, 0xdc, 0xd7, 0x8c, 0x3c, 0xd4, 0x8c, 0xfc,
-	0xaa, 0x19, 0xf9, 0xb6, 0x65, 0xde, 0xc3, 0x96, 0x79, 0x3f, 0xb7, 0xcc, 0xfb, 0xf8, 0xf6, 0xc9,
-	0x9a, 0x4a, 0x23, 0x58, 0x2d, 0xd6, 0xfd, 0x3d, 0x25, 0x93, 0xee, 0x3a, 0xde, 0xfc, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xcd, 0xcc, 0xb9, 0x28, 0x7b, 0x02, 0x00, 0x00,
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0x8e, 0x1b, 0x29, 0x55, 0x9d, 0x14, 0xa1, 0xa5, 0x87, 0x0a, 0x24, 0x6f, 0x15, 0xa9, 0xb4,
+	0xa7, 0x58, 0x85, 0x07, 0x40, 0x4a, 0x2e, 0xa0, 0xa2, 0x22, 0x39, 0x37, 0x2e, 0x91, 0xe3, 0x4c,
+	0x36, 0x56, 0x12, 0x7b, 0x65, 0x4f, 0x0b, 0xfb, 0x0e, 0x1c, 0x78, 0x0c, 0x1e, 0xa5, 0xc7, 0x1e,
+	0x39, 0xad, 0x20, 0x11, 0x97, 0x7d, 0x04, 0x4e, 0x68, 0xbd, 0x5b, 0xb2, 0x3d, 0xe6, 0x36, 0xf2,
+	0x7c, 0xe3, 0xef, 0x67, 0x86, 0x5e, 0x78, 0x50, 0x0e, 0x90, 0x3b, 0x48, 0xb4, 0x47, 0x27, 0x51,
+	0x5b, 0xc3, 0xef, 0xae, 0xa6, 0x80, 0xf2, 0x8a, 0x63, 0x96, 0x82, 0x1f, 0xa4, 0xce, 0xa2, 0x8d,
+	0x5e, 0x55, 0xc0, 0x41, 0x13, 0x38, 0xa8, 0x81, 0x2f, 0x4f, 0x12, 0x9b, 0xd8, 0x80, 0xe3, 0x65,
+	0x55, 0x8d, 0xf4, 0xbf, 0x11, 0x4a, 0xc7, 0x00, 0xb3, 0x91, 0x35, 0x73, 0x9d, 0x44, 0xaf, 0x29,
+	0x5d, 0x4b, 0x8f, 0xe0, 0x26, 0x4b, 0xc8, 0x4e, 0xc9, 0x19, 0xb9, 0x3c, 0x1a, 0x1e, 0x16, 0x79,
+	0xdc, 0x4e, 0x97, 0x6f, 0xc4, 0x51, 0xd5, 0xba, 0x86, 0x2c, 0xe2, 0xf4, 0x18, 0x8c, 0x72, 0x59,
+	0x8a, 0x30, 0x0b, 0xd0, 0x83, 0x00, 0xa5, 0x45, 0x1e, 0x77, 0xc0, 0xa8, 0x6b, 0xc8, 0x44, 0xef,
+	0x3f, 0xa0, 0x1c, 0x38, 0xa7, 0x87, 0x77, 0xe0, 0xbc, 0xb6, 0xe6, 0xb4, 0x7d, 0x46, 0x2e, 0x8f,
+	0x87, 0xdd, 0x22, 0x8f, 0x1f, 0x9f, 0xc4, 0x63, 0xd1, 0x5f, 0xd1, 0xe7, 0x1f, 0x21, 0x91, 0x2a,
+	0x6b, 0x68, 0xba, 0xa0, 0xdd, 0x5a, 0x93, 0x02, 0x87, 0xb5, 0xa8, 0x4e, 0x91, 0xc7, 0x07, 0xe9,
+	0x52, 0xd4, 0x72, 0x47, 0xe0, 0x70, 0x6f, 0x51, 0xfd, 0x3f, 0x84, 0x9e, 0x88, 0x46, 0x56, 0x37,
+	0x76, 0x06, 0x1f, 0xcc, 0xdc, 0x46, 0xb7, 0xb4, 0x5b, 0x72, 0xe9, 0xb9, 0x56, 0x12, 0x21, 0x50,
+	0xf6, 0x86, 0xe3, 0xbf, 0x79, 0xfc, 0x29, 0xd1, 0xb8, 0xb8, 0x9d, 0x0e, 0x94, 0x5d, 0x73, 0xaf,
+	0x1c, 0xae, 0xe4, 0xd4, 0xf3, 0x71, 0x48, 0xfd, 0x06, 0xf0, 0x8b, 0x75, 0x4b, 0xfe, 0xf5, 0xe9,
+	0x9e, 0x1c, 0xac, 0x2d, 0xc2, 0x44, 0x22, 0x82, 0xc7, 0x6a, 0x23, 0xa3, 0xdd, 0xd7, 0xa2, 0xc9,
+	0x13, 0x9d, 0xd3, 0x67, 0x3b, 0x03, 0x1e, 0x60, 0x16, 0x1c, 0xf4, 0xc4, 0xce, 0x56, 0x19, 0x4b,
+	0xc4, 0xe9, 0x8b, 0x26, 0xc5, 0x64, 0x01, 0x3a, 0x59, 0x60, 0xc8, 0xb5, 0x2d, 0xa2, 0x66, 0xeb,
+	0x7d, 0xe8, 0x0c, 0xe5, 0xfd, 0x6f, 0xd6, 0xfa, 0xb1, 0x61, 0xe4, 0x7e, 0xc3, 0xc8, 0xc3, 0x86,
+	0x91, 0x5f, 0x1b, 0x46, 0xbe, 0x6f, 0x59, 0xeb, 0x61, 0xcb, 0x5a, 0x3f, 0xb7, 0xac, 0xf5, 0xf9,
+	0xdd, 0xde, 0xbe, 0xb4, 0x41, 0x70, 0x46, 0xae, 0xaa, 0x03, 0x9c, 0x76, 0xc2, 0x39, 0xbd, 0xfd,
+	0x17, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xa4, 0x8a, 0x21, 0xac, 0x02, 0x00, 0x00,
 }
 
 func (this *SeedConfig) Equal(that interface{}) bool {
@@ -261,6 +264,9 @@ func (this *RegistrationNodeInfo) Equal(that interface{}) bool {
 	if !bytes.Equal(this.EncryptedSeed, that1.EncryptedSeed) {
 		return false
 	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
 	return true
 }
 func (m *SeedConfig) Marshal() (dAtA []byte, err error) {
@@ -362,6 +368,11 @@ func (m *RegistrationNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.EncryptedSeed) > 0 {
 		i -= len(m.EncryptedSeed)
 		copy(dAtA[i:], m.EncryptedSeed)
@@ -441,6 +452,9 @@ func (m *RegistrationNodeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RegistrationHeight))
+	}
 	return n
 }
 
@@ -794,6 +808,25 @@ func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
 				m.EncryptedSeed = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,135 @@
+package remote_attestation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"unsafe"
+
+	"github.com/pkg/errors"
+)
+
+// AttestationType is the scheme an attestation report was created with
+type AttestationType int
+
+const (
+	// AttestationTypeEpid is an EPID attestation certificate, verified against Intel's attestation service report
+	AttestationTypeEpid AttestationType = iota
+	// AttestationTypeDcap is a DCAP quote
+	AttestationTypeDcap
+)
+
+func (t AttestationType) String() string {
+	switch t {
+	case AttestationTypeEpid:
+		return "EPID"
+	case AttestationTypeDcap:
+		return "DCAP"
+	default:
+		return fmt.Sprintf("AttestationType(%d)", int(t))
+	}
+}
+
+// Attestation is a report out of a combined certificate, together with the scheme it was created with
+type Attestation struct {
+	Type   AttestationType
+	Report []byte
+}
+
+// AttestationVerifier verifies the attestation reports of a single scheme
+type AttestationVerifier interface {
+	// Type returns the scheme of the reports the verifier can verify
+	Type() AttestationType
+	// Verify verifies report and returns the public key of the enclave that created it
+	Verify(report []byte) ([]byte, error)
+}
+
+// attestationVerifiers are the verifiers VerifyCombinedCert selects from by the type of the report.
+// Supporting another scheme means adding its verifier here and its report to ParseCombinedCert.
+var attestationVerifiers = map[AttestationType]AttestationVerifier{
+	AttestationTypeEpid: EpidVerifier{},
+	AttestationTypeDcap: DcapVerifier{},
+}
+
+// VerifyAttestation verifies attestation with verifier, it fails without verifying anything if
+// the verifier is for another scheme
+func VerifyAttestation(verifier AttestationVerifier, attestation Attestation) ([]byte, error) {
+	if verifier.Type() != attestation.Type {
+		return nil, fmt.Errorf("%s verifier can't verify a %s attestation", verifier.Type(), attestation.Type)
+	}
+	return verifier.Verify(attestation.Report)
+}
+
+// ParseCombinedCert extracts the attestation report out of a combined certificate. The header
+// holds the sizes of the EPID certificate and the DCAP quote that follow it, and the size of the
+// DCAP collateral. If the certificate holds both reports, the EPID one is used.
+func ParseCombinedCert(blob []byte) (Attestation, error) {
+	var hdr CombinedHdr
+
+	if uintptr(len(blob)) < unsafe.Sizeof(hdr) {
+		return Attestation{}, errors.New("Combined hdr too small")
+	}
+
+	{
+		buf := bytes.NewReader(blob)
+		err := binary.Read(buf, binary.LittleEndian, &hdr)
+		if err != nil {
+			return Attestation{}, err
+		}
+	}
+
+	idx0 := unsafe.Sizeof(hdr)
+	idx1 := idx0 + uintptr(hdr.M_CombinedSizes[0])
+	idx2 := idx1 + uintptr(hdr.M_CombinedSizes[1])
+	idx3 := idx2 + uintptr(hdr.M_CombinedSizes[2])
+
+	if uintptr(len(blob)) < idx3 {
+		return Attestation{}, errors.New("combined hdr invalid")
+	}
+
+	if idx1 > idx0 {
+		return Attestation{Type: AttestationTypeEpid, Report: blob[idx0:idx1]}, nil
+	}
+
+	if idx2 > idx1 {
+		return Attestation{Type: AttestationTypeDcap, Report: blob[idx1:idx2]}, nil
+	}
+
+	return Attestation{}, errors.New("No valid attestatoin found")
+}
+
+// EpidVerifier verifies EPID attestation certificates with VerifyRaCert
+type EpidVerifier struct{}
+
+func (EpidVerifier) Type() AttestationType {
+	return AttestationTypeEpid
+}
+
+func (EpidVerifier) Verify(report []byte) ([]byte, error) {
+	ret_pk, ret_err := VerifyRaCert(report)
+	if ret_pk != nil {
+		fmt.Println("EPID quote Extracted pk: ", hex.EncodeToString(ret_pk))
+	}
+	return ret_pk, ret_err
+}
+
+// DcapVerifier extracts the enclave's public key out of the report data of a DCAP quote
+type DcapVerifier struct{}
+
+func (DcapVerifier) Type() AttestationType {
+	return AttestationTypeDcap
+}
+
+func (DcapVerifier) Verify(report []byte) ([]byte, error) {
+	var quote DcapQuote
+
+	buf := bytes.NewReader(report)
+	err := binary.Read(buf, binary.LittleEndian, &quote)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Println("DCAP quote Extracted pk: ", hex.EncodeToString(quote.M_PubKey[:]))
+	return quote.M_PubKey[:], nil
+}
@@ -0,0 +1,132 @@
+package remote_attestation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// combine builds a combined certificate out of an EPID certificate and a DCAP quote
+func combine(epidCert []byte, dcapQuote []byte) []byte {
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.LittleEndian, CombinedHdr{M_CombinedSizes: [3]uint32{uint32(len(epidCert)), uint32(len(dcapQuote)), 0}})
+	buf.Write(epidCert)
+	buf.Write(dcapQuote)
+	return buf.Bytes()
+}
+
+func Test_VerifyCombinedCertEpid(t *testing.T) {
+	for _, spec := range []struct {
+		mode string
+		file string
+	}{
+		{mode: "SW", file: "../testdata/attestation_cert_sw"},
+		{mode: "HW", file: "../testdata/attestation_cert_hw_v2"},
+	} {
+		t.Run(spec.mode, func(t *testing.T) {
+			cert, err := os.ReadFile(spec.file)
+			require.NoError(t, err)
+			_ = os.Setenv("SGX_MODE", spec.mode)
+
+			expPubKey, err := VerifyRaCert(cert)
+			require.NoError(t, err)
+
+			combined := combine(cert, nil)
+			attestation, err := ParseCombinedCert(combined)
+			require.NoError(t, err)
+			require.Equal(t, AttestationTypeEpid, attestation.Type)
+			require.Equal(t, cert, attestation.Report)
+
+			pubKey, err := VerifyCombinedCert(combined)
+			require.NoError(t, err)
+			require.Equal(t, expPubKey, pubKey)
+		})
+	}
+}
+
+func Test_VerifyCombinedCertSwFixture(t *testing.T) {
+	combined, err := os.ReadFile("../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	_ = os.Setenv("SGX_MODE", "SW")
+
+	attestation, err := ParseCombinedCert(combined)
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeEpid, attestation.Type)
+
+	expPubKey, err := VerifyRaCert(attestation.Report)
+	require.NoError(t, err)
+	pubKey, err := VerifyCombinedCert(combined)
+	require.NoError(t, err)
+	require.Equal(t, expPubKey, pubKey)
+}
+
+func Test_VerifyCombinedCertDcap(t *testing.T) {
+	// attestation_dcap.combined holds a DCAP quote whose report data starts with the bytes 1 to 32
+	combined, err := os.ReadFile("../testdata/attestation_dcap.combined")
+	require.NoError(t, err)
+
+	attestation, err := ParseCombinedCert(combined)
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeDcap, attestation.Type)
+
+	expPubKey := make([]byte, 32)
+	for i := range expPubKey {
+		expPubKey[i] = byte(i + 1)
+	}
+	pubKey, err := VerifyCombinedCert(combined)
+	require.NoError(t, err)
+	require.Equal(t, expPubKey, pubKey)
+}
+
+func Test_VerifyCombinedCertPrefersEpid(t *testing.T) {
+	cert, err := os.ReadFile("../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+	dcap, err := os.ReadFile("../testdata/attestation_dcap.combined")
+	require.NoError(t, err)
+
+	attestation, err := ParseCombinedCert(combine(cert, dcap[12:]))
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeEpid, attestation.Type)
+}
+
+func Test_VerifyAttestationMismatchedType(t *testing.T) {
+	cert, err := os.ReadFile("../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+	_ = os.Setenv("SGX_MODE", "SW")
+	dcap, err := os.ReadFile("../testdata/attestation_dcap.combined")
+	require.NoError(t, err)
+
+	epidAttestation, err := ParseCombinedCert(combine(cert, nil))
+	require.NoError(t, err)
+	dcapAttestation, err := ParseCombinedCert(dcap)
+	require.NoError(t, err)
+
+	_, err = VerifyAttestation(DcapVerifier{}, epidAttestation)
+	require.ErrorContains(t, err, "DCAP verifier can't verify a EPID attestation")
+	_, err = VerifyAttestation(EpidVerifier{}, dcapAttestation)
+	require.ErrorContains(t, err, "EPID verifier can't verify a DCAP attestation")
+
+	_, err = VerifyAttestation(EpidVerifier{}, epidAttestation)
+	require.NoError(t, err)
+	_, err = VerifyAttestation(DcapVerifier{}, dcapAttestation)
+	require.NoError(t, err)
+}
+
+func Test_ParseCombinedCertInvalid(t *testing.T) {
+	for name, spec := range map[string]struct {
+		blob   []byte
+		expErr string
+	}{
+		"too small":      {blob: []byte{0x1, 0x0}, expErr: "Combined hdr too small"},
+		"sizes too big":  {blob: combine([]byte("cert"), nil)[:14], expErr: "combined hdr invalid"},
+		"no attestation": {blob: combine(nil, nil), expErr: "No valid attestatoin found"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseCombinedCert(spec.blob)
+			require.ErrorContains(t, err, spec.expErr)
+		})
+	}
+}
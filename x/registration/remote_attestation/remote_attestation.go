@@ -4,12 +4,10 @@ import (
 	"bytes"
 	"crypto/x509"
 	"encoding/base64"
-	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
-	"unsafe"
 
 	"github.com/pkg/errors"
 )
@@ -26,52 +24,19 @@ type DcapQuote struct {
 	M_SigLen  uint32
 }
 
+// VerifyCombinedCert verifies the attestation report in a combined certificate with the verifier
+// for its type, and returns the public key of the enclave that created it
 func VerifyCombinedCert(blob []byte) ([]byte, error) {
-	var hdr CombinedHdr
-
-	if uintptr(len(blob)) < unsafe.Sizeof(hdr) {
-		return nil, errors.New("Combined hdr too small")
-	}
-
-	{
-		buf := bytes.NewReader(blob)
-		err := binary.Read(buf, binary.LittleEndian, &hdr)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	idx0 := unsafe.Sizeof(hdr)
-	idx1 := idx0 + uintptr(hdr.M_CombinedSizes[0])
-	idx2 := idx1 + uintptr(hdr.M_CombinedSizes[1])
-	idx3 := idx2 + uintptr(hdr.M_CombinedSizes[2])
-
-	if uintptr(len(blob)) < idx3 {
-		return nil, errors.New("combined hdr invalid")
-	}
-
-	if idx1 > idx0 {
-		ret_pk, ret_err := VerifyRaCert(blob[idx0:idx1])
-		if ret_pk != nil {
-			fmt.Println("EPID quote Extracted pk: ", hex.EncodeToString(ret_pk))
-		}
-		return ret_pk, ret_err
+	attestation, err := ParseCombinedCert(blob)
+	if err != nil {
+		return nil, err
 	}
 
-	if idx2 > idx1 {
-		var quote DcapQuote
-
-		buf := bytes.NewReader(blob[idx1:idx2])
-		err := binary.Read(buf, binary.LittleEndian, &quote)
-		if err != nil {
-			return nil, err
-		}
-
-		fmt.Println("DCAP quote Extracted pk: ", hex.EncodeToString(quote.M_PubKey[:]))
-		return quote.M_PubKey[:], nil
+	verifier, ok := attestationVerifiers[attestation.Type]
+	if !ok {
+		return nil, fmt.Errorf("no verifier for %s attestations", attestation.Type)
 	}
-
-	return nil, errors.New("No valid attestatoin found")
+	return VerifyAttestation(verifier, attestation)
 }
 
 /*